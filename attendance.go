@@ -0,0 +1,53 @@
+package gomts
+
+import (
+	"context"
+	"time"
+)
+
+// AttendanceClient interfaces with attendance related MyTimeStation API
+// methods.
+type AttendanceClient interface {
+	// GetAbsences lists the scheduled shifts within the date range which the
+	// employee never clocked in for.
+	//
+	// Not yet implemented: this requires correlating shifts with time records,
+	// neither of which are supported by this client yet. Always returns
+	// ErrNotImplemented.
+	GetAbsences(ctx context.Context, r DateRange) ([]AbsenceRecord, error)
+}
+
+// DateRange represents a range of time. Start is inclusive and End is
+// exclusive.
+type DateRange struct {
+	// Start is the first instant of the range.
+	Start time.Time
+
+	// End is the first instant after the range.
+	End time.Time
+}
+
+// AbsenceRecord represents a scheduled shift an employee did not work.
+type AbsenceRecord struct {
+	// Employee is the absent employee.
+	Employee Employee
+
+	// ScheduledDate is the date the employee was scheduled to work.
+	ScheduledDate time.Time
+
+	// ShiftID is the unique identifier of the missed shift.
+	ShiftID string
+}
+
+// attendanceClient implements AttendanceClient.
+type attendanceClient struct {
+	*client
+}
+
+func (c *attendanceClient) GetAbsences(ctx context.Context, r DateRange) ([]AbsenceRecord, error) {
+	return nil, ErrNotImplemented
+}
+
+// compile-time assertion that attendanceClient implementation fulfils
+// AttendanceClient interface.
+var _ AttendanceClient = (*attendanceClient)(nil)
@@ -0,0 +1,177 @@
+package gomts
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/go-querystring/query"
+)
+
+// AuditLogger records the operations performed by the client, e.g. to keep a
+// local audit trail for compliance.
+type AuditLogger interface {
+	// LogOperation logs a single operation. It is called synchronously after
+	// the response is received so it must not block or make calls with the
+	// client.
+	LogOperation(ctx context.Context, op AuditOperation)
+}
+
+// AuditOperation represents a single create, update or delete operation made
+// against the MyTimeStation API.
+type AuditOperation struct {
+	// Method is the HTTP method of the request.
+	Method string `json:"method"`
+
+	// Path is the path of the request relative to the base URL.
+	Path string `json:"path"`
+
+	// EmployeeID is the ID of the employee operated on, if any.
+	EmployeeID string `json:"employee_id,omitempty"`
+
+	// DepartmentID is the ID of the department operated on, if any.
+	DepartmentID string `json:"department_id,omitempty"`
+
+	// Request is the request body, if any. Employee PINs are redacted.
+	Request any `json:"request,omitempty"`
+
+	// Response is the response body, if the operation succeeded. Employee PINs
+	// are redacted.
+	Response any `json:"response,omitempty"`
+
+	// Error is the error the operation failed with, if any.
+	Error error `json:"-"`
+
+	// Timestamp is when the response was received.
+	Timestamp time.Time `json:"timestamp"`
+}
+
+// newAuditOperation builds an AuditOperation, resolving the ID of the employee
+// or department operated on from the path or, for creates, the response.
+func newAuditOperation(method, path string, req, resp any, err error) AuditOperation {
+	op := AuditOperation{
+		Method:    method,
+		Path:      path,
+		Request:   redactPIN(req),
+		Response:  redactPIN(resp),
+		Error:     err,
+		Timestamp: time.Now(),
+	}
+
+	// paths are of the form /{resource}/{id}/...
+	segments := strings.Split(strings.TrimPrefix(path, "/"), "/")
+
+	var id string
+	if len(segments) > 1 {
+		id = segments[1]
+	}
+
+	switch resp := resp.(type) {
+	case *EmployeeResponse:
+		id = resp.Employee.ID
+	case *DepartmentResponse:
+		id = resp.Department.ID
+	}
+
+	switch segments[0] {
+	case "employees":
+		op.EmployeeID = id
+	case "departments":
+		op.DepartmentID = id
+	}
+
+	return op
+}
+
+// redactedPIN replaces employee PINs in audited requests and responses.
+const redactedPIN = "REDACTED"
+
+// redactPIN returns a copy of v with any employee PIN replaced by redactedPIN
+// so PINs never reach the AuditLogger. Other values are returned as they are.
+func redactPIN(v any) any {
+	switch v := v.(type) {
+	case *EmployeeCreateRequest:
+		if v != nil && v.PIN != "" {
+			redacted := *v
+			redacted.PIN = redactedPIN
+
+			return &redacted
+		}
+
+	case *EmployeeUpdateRequest:
+		if v != nil && v.PIN != nil {
+			pin := redactedPIN
+
+			redacted := *v
+			redacted.PIN = &pin
+
+			return &redacted
+		}
+
+	case *EmployeeResponse:
+		if v != nil && v.Employee.PIN != "" {
+			redacted := *v
+			redacted.Employee.PIN = redactedPIN
+
+			return &redacted
+		}
+	}
+
+	return v
+}
+
+// isAuditedMethod reports whether requests with the method modify resources and
+// so should be audited.
+func isAuditedMethod(method string) bool {
+	return method != http.MethodGet && method != http.MethodHead
+}
+
+// NewJSONAuditLogger returns an AuditLogger which writes each operation to w as
+// a JSON line. Writes are serialized.
+func NewJSONAuditLogger(w io.Writer) AuditLogger {
+	return &jsonAuditLogger{
+		mtx: new(sync.Mutex),
+		enc: json.NewEncoder(w),
+	}
+}
+
+// jsonAuditLogger implements AuditLogger.
+type jsonAuditLogger struct {
+	// mtx protects the following resources
+	mtx *sync.Mutex
+	enc *json.Encoder
+}
+
+func (l *jsonAuditLogger) LogOperation(ctx context.Context, op AuditOperation) {
+	line := struct {
+		AuditOperation
+		Error string `json:"error,omitempty"`
+	}{
+		AuditOperation: op,
+	}
+
+	if op.Error != nil {
+		line.Error = op.Error.Error()
+	}
+
+	// form requests have no JSON tags so are logged as they are sent
+	if _, ok := op.Request.(formRequest); ok {
+		if values, err := query.Values(op.Request); err == nil {
+			line.Request = values
+		}
+	}
+
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+
+	// the audit trail is best effort and must not fail the operation
+	_ = l.enc.Encode(&line)
+}
+
+// compile-time assertion that jsonAuditLogger implementation fulfils
+// AuditLogger interface.
+var _ AuditLogger = (*jsonAuditLogger)(nil)
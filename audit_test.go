@@ -0,0 +1,108 @@
+package gomts_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.charbar.io/gomts"
+)
+
+func TestJSONAuditLogger(t *testing.T) {
+	var auditLog bytes.Buffer
+
+	client := fakeServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodPost:
+			fmt.Fprint(w, `{"department": {"department_id": "dep_1", "name": "painters"}}`)
+		case http.MethodGet:
+			fmt.Fprint(w, `{"departments": []}`)
+		case http.MethodDelete:
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(w, `{"error": {"error_code": 404, "error_text": "not found"}}`)
+		}
+	}, func(conf *gomts.Config) {
+		conf.AuditLogger = gomts.NewJSONAuditLogger(&auditLog)
+	})
+
+	ctx := context.Background()
+
+	_, err := client.Departments().Create(ctx, &gomts.DepartmentCreateRequest{Name: "painters"})
+	assert.NoError(t, err)
+
+	_, err = client.Departments().List(ctx)
+	assert.NoError(t, err)
+
+	_, err = client.Departments().Delete(ctx, "dep_2")
+	assert.Error(t, err)
+
+	var ops []map[string]any
+
+	dec := json.NewDecoder(&auditLog)
+	for dec.More() {
+		var op map[string]any
+		assert.NoError(t, dec.Decode(&op))
+
+		ops = append(ops, op)
+	}
+
+	if assert.Len(t, ops, 2) {
+		assert.Equal(t, "POST", ops[0]["method"])
+		assert.Equal(t, "/departments", ops[0]["path"])
+		assert.Equal(t, "dep_1", ops[0]["department_id"])
+		assert.Equal(t, map[string]any{"name": []any{"painters"}}, ops[0]["request"])
+		assert.NotContains(t, ops[0], "error")
+
+		assert.Equal(t, "DELETE", ops[1]["method"])
+		assert.Equal(t, "dep_2", ops[1]["department_id"])
+		assert.Contains(t, ops[1]["error"], "not found")
+		assert.NotContains(t, ops[1], "response")
+	}
+}
+
+func TestJSONAuditLoggerRedactsPIN(t *testing.T) {
+	var auditLog bytes.Buffer
+
+	client := fakeServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"employee": {"employee_id": "emp_1", "pin": "1234"}}`)
+	}, func(conf *gomts.Config) {
+		conf.AuditLogger = gomts.NewJSONAuditLogger(&auditLog)
+	})
+
+	ctx := context.Background()
+
+	createRequest := &gomts.EmployeeCreateRequest{Name: "bob ross", DepartmentID: "dep_1", PIN: "1234"}
+
+	_, err := client.Employees().Create(ctx, createRequest)
+	assert.NoError(t, err)
+
+	_, err = client.Employees().SetPIN(ctx, "emp_1", "1234")
+	assert.NoError(t, err)
+
+	// the caller's request is not modified
+	assert.Equal(t, "1234", createRequest.PIN)
+
+	assert.NotContains(t, auditLog.String(), "1234")
+
+	var ops []map[string]any
+
+	dec := json.NewDecoder(&auditLog)
+	for dec.More() {
+		var op map[string]any
+		assert.NoError(t, dec.Decode(&op))
+
+		ops = append(ops, op)
+	}
+
+	if assert.Len(t, ops, 2) {
+		assert.Equal(t, []any{"REDACTED"}, ops[0]["request"].(map[string]any)["pin"])
+		assert.Equal(t, "REDACTED", ops[0]["response"].(map[string]any)["employee"].(map[string]any)["pin"])
+
+		assert.Equal(t, "REDACTED", ops[1]["request"].(map[string]any)["pin"])
+		assert.Equal(t, "REDACTED", ops[1]["response"].(map[string]any)["employee"].(map[string]any)["pin"])
+	}
+}
@@ -1,10 +1,15 @@
 package gomts
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
+	"io"
 	"log/slog"
 	"net/http"
 	"os"
+	"sync"
+	"time"
 )
 
 const (
@@ -13,12 +18,24 @@ const (
 	defaultHost       = "api.mytimestation.com"
 	defaultAPIVersion = "v1.2"
 
+	defaultBatchConcurrency = 10
+	defaultMaxRetries       = 3
+
 	authTokenEnvVar = "MTS_AUTH_TOKEN"
 )
 
 // NewClient returns a new client with the given config.
 func NewClient(conf *Config) Client {
-	return newClient(conf)
+	c := newClient(conf)
+
+	// warn here rather than in newClient so clients derived with WithToken
+	// do not repeat it
+	if conf.Debug && conf.LogLevel != nil {
+		c.logr.Warn("both debug and log level are set; debug takes precedence",
+			slog.String("log_level", conf.LogLevel.Level().String()))
+	}
+
+	return c
 }
 
 // Client represents client to the MyTimeStation API.
@@ -30,6 +47,27 @@ type Client interface {
 	// Departments returns the DepartmentClient, which handles operations
 	// related to departments within MyTimeStation.
 	Departments() DepartmentClient
+
+	// Attendance returns the AttendanceClient, which handles operations
+	// related to employee attendance within MyTimeStation.
+	Attendance() AttendanceClient
+
+	// ClockEvents returns the ClockEventClient configured as
+	// Config.ClockEventSource, which lists employee punches.
+	ClockEvents() ClockEventClient
+
+	// Summary returns a snapshot of employee and department statistics, e.g.
+	// for an admin dashboard.
+	Summary(ctx context.Context) (*Summary, error)
+
+	// Ping checks that the MyTimeStation API is reachable and the auth token
+	// is accepted; see HealthHandler.
+	Ping(ctx context.Context) error
+
+	// WithToken returns a new client with the same config but a different
+	// auth token, e.g. for managing multiple MyTimeStation accounts. The new
+	// client shares the connection pool of this client.
+	WithToken(token string) Client
 }
 
 // Config configures the underlying HTTP client that interfaces with
@@ -48,9 +86,14 @@ type Config struct {
 	// APIVersion specifies the version of the MyTimeStation API to use.
 	APIVersion string
 
-	// Debug enables request and response dumping.
+	// Debug enables request and response dumping. Also sets the log level of
+	// the default logger to debug, taking precedence over LogLevel.
 	Debug bool
 
+	// LogLevel sets the level of the default logger. Defaults to info if nil.
+	// Has no effect if LogHandler is set.
+	LogLevel slog.Leveler
+
 	// Token is the auth token to use for Basic Auth.
 	// If not set $MTS_AUTH_TOKEN is used.
 	AuthToken string
@@ -61,10 +104,88 @@ type Config struct {
 	// http.DefaultTransport.
 	Transport http.RoundTripper
 
+	// StrictJSON fails decoding responses containing fields not captured by
+	// the response types. Useful for detecting API changes while debugging.
+	StrictJSON bool
+
+	// JSONDecodeHook can be specified to customize the json.Decoder used for
+	// every response (e.g., to call UseNumber). Called after StrictJSON is
+	// applied.
+	JSONDecodeHook func(dec *json.Decoder)
+
+	// HeaderTransformer can be specified to add custom headers to every
+	// request. Called after all standard headers are set, including
+	// Authorization, which cannot be overwritten.
+	HeaderTransformer func(h http.Header)
+
+	// OnError can be specified to observe every error response from the
+	// MyTimeStation API, e.g. to report it to an error tracking service. It is
+	// called synchronously before the error is returned so it must not block
+	// or make calls with the client.
+	OnError func(err error, req *http.Request)
+
+	// ForceHTTP2 configures the underlying transport for HTTP/2 with
+	// golang.org/x/net/http2. Needed when a custom *http.Transport is specified
+	// with its own TLS config or dialer, which disables HTTP/2 by default. Has
+	// no effect if Transport is already an *http2.Transport or is not an
+	// *http.Transport.
+	ForceHTTP2 bool
+
+	// ValidatePINUniqueness enables checking that no other employee has the
+	// same PIN before creating an employee. This costs an extra list call per
+	// create and is subject to races with concurrent creates.
+	ValidatePINUniqueness bool
+
+	// PINGenerator generates PINs for EmployeeCreateRequest.GeneratePIN.
+	// Defaults to a cryptographically random 4-digit PIN.
+	PINGenerator func() (string, error)
+
+	// EmployeeChangeLog can be specified to record the changes made by every
+	// EmployeeClient.Update call as JSON lines, as the MyTimeStation API does
+	// not keep a history of employee records. Each update costs an extra get
+	// call. Writes are serialized.
+	EmployeeChangeLog io.Writer
+
+	// Timezone is the timezone used to compute days and weeks, e.g. for
+	// "today" and "this week". Defaults to time.Local.
+	Timezone *time.Location
+
+	// ClockEventSource supplies the clock events of employees, e.g. with
+	// NewClockEventSource, as the MyTimeStation API does not document an
+	// endpoint for punch history. Methods deriving worked time from clock
+	// events return ErrNotImplemented if it is not set.
+	ClockEventSource ClockEventClient
+
+	// BatchConcurrency bounds the number of concurrent requests made by batch
+	// operations such as BatchAssignDepartment. Defaults to 10.
+	BatchConcurrency int
+
+	// Retry enables retrying failed idempotent requests with a
+	// RetryTransport wrapping Transport. Defaults to no retries.
+	Retry *RetryConfig
+
+	// RateLimitPerSecond limits the rate of requests made by the client, with
+	// bursts of up to int(RateLimitPerSecond)+1 requests. Clients created with
+	// WithToken have their own limit. This is a convenience for the common
+	// case; wrap Transport with NewRateLimitTransport directly to configure
+	// the burst or share a limit between clients. Defaults to no limit.
+	RateLimitPerSecond float64
+
+	// AuditLogger can be specified to record every create, update and delete
+	// operation, successful or not, e.g. with NewJSONAuditLogger.
+	AuditLogger AuditLogger
+
 	// LogHandler can be specified to cutomize the slog.Logger.
 	LogHandler slog.Handler
 }
 
+// Copy returns a shallow copy of the config. Transports, handlers, writers
+// and functions are shared with the original.
+func (c *Config) Copy() *Config {
+	out := *c
+	return &out
+}
+
 // GetAuthToken gets the configured auth token or the MTS_AUTH_TOKEN
 // environment variable.
 func (c *Config) GetAuthToken() string {
@@ -123,21 +244,63 @@ func (c *Config) GetBaseURL() string {
 		c.GetAPIVersion())
 }
 
+// GetPINGenerator gets the configured PIN generator or the default.
+func (c *Config) GetPINGenerator() func() (string, error) {
+	if c.PINGenerator == nil {
+		return generatePIN
+	}
+
+	return c.PINGenerator
+}
+
+// GetTimezone gets the configured timezone or the default.
+func (c *Config) GetTimezone() *time.Location {
+	if c.Timezone == nil {
+		return time.Local
+	}
+
+	return c.Timezone
+}
+
+// GetClockEventSource gets the configured clock event source or one which
+// always returns ErrNotImplemented.
+func (c *Config) GetClockEventSource() ClockEventClient {
+	if c.ClockEventSource == nil {
+		return unsupportedClockEventClient{}
+	}
+
+	return c.ClockEventSource
+}
+
+// GetBatchConcurrency gets the configured batch concurrency or the default.
+func (c *Config) GetBatchConcurrency() int {
+	if c.BatchConcurrency <= 0 {
+		return defaultBatchConcurrency
+	}
+
+	return c.BatchConcurrency
+}
+
 // GetLogger returns a *slog.Logger built from the configured slog.Handler or
 // builds a default, text-based logger.
 //
-// Default log level will be `info`. If `debug` is `true`, it will be `debug`.
+// Default log level will be the configured log level, which defaults to
+// `info`. If `debug` is `true`, it will be `debug`.
 func (c *Config) GetLogger() *slog.Logger {
 	if c.LogHandler != nil {
 		// use user-specified log handler
 		return slog.New(c.LogHandler)
 	}
 
-	level := slog.LevelInfo.Level()
+	var level slog.Leveler = slog.LevelInfo
+
+	if c.LogLevel != nil {
+		level = c.LogLevel
+	}
 
 	if c.Debug {
 		// up the log level to enable debug logging
-		level = slog.LevelDebug.Level()
+		level = slog.LevelDebug
 	}
 
 	return slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{
@@ -149,17 +312,24 @@ func (c *Config) GetLogger() *slog.Logger {
 // authentication and request/response dumping.
 func (c *Config) GetTransport() *mtsTransport {
 	return &mtsTransport{
-		conf: c,
-		logr: slog.Default(),
+		conf:      c,
+		logr:      slog.Default(),
+		http2Once: new(sync.Once),
 	}
 }
 
 type client struct {
 	conf       *Config
 	httpClient *http.Client
+	transport  *mtsTransport
 
 	logr *slog.Logger
 
+	// changeLogMtx serializes writes to conf.EmployeeChangeLog
+	changeLogMtx *sync.Mutex
+
+	attendance  *attendanceClient
+	clockEvents ClockEventClient
 	departments *departmentClient
 	employees   *employeeClient
 }
@@ -172,14 +342,22 @@ func newClient(conf *Config) *client {
 
 	httpClient := &http.Client{Transport: transport}
 
+	if conf.RateLimitPerSecond > 0 {
+		httpClient.Transport = NewRateLimitTransport(transport, conf.RateLimitPerSecond, int(conf.RateLimitPerSecond)+1)
+	}
+
 	c := &client{
-		conf:       conf,
-		logr:       logr,
-		httpClient: httpClient,
+		conf:         conf,
+		logr:         logr,
+		httpClient:   httpClient,
+		transport:    transport,
+		changeLogMtx: new(sync.Mutex),
 	}
 
 	c.employees = (*employeeClient)(c)
 	c.departments = &departmentClient{c}
+	c.attendance = &attendanceClient{c}
+	c.clockEvents = conf.GetClockEventSource()
 
 	return c
 }
@@ -192,8 +370,131 @@ func (c *client) Departments() DepartmentClient {
 	return c.departments
 }
 
+func (c *client) Attendance() AttendanceClient {
+	return c.attendance
+}
+
+func (c *client) ClockEvents() ClockEventClient {
+	return c.clockEvents
+}
+
+func (c *client) WithToken(token string) Client {
+	conf := c.conf.Copy()
+	conf.AuthToken = token
+
+	// share the underlying transport, and so its connection pool, which is
+	// already configured for HTTP/2 if forced
+	conf.Transport = c.transport.getWrappedTransport()
+	conf.ForceHTTP2 = false
+
+	out := newClient(conf)
+
+	// both clients may write to the same change log
+	out.changeLogMtx = c.changeLogMtx
+
+	return out
+}
+
+// now returns the current time in the configured timezone.
+func (c *client) now() time.Time {
+	return time.Now().In(c.conf.GetTimezone())
+}
+
+// Meta represents the pagination metadata of a list response. The
+// MyTimeStation API does not paginate yet, in which case all fields are zero;
+// a non-zero TotalCount signals the server is paginating.
+type Meta struct {
+	// TotalCount is the total number of items across all pages.
+	TotalCount int `json:"total_count"`
+
+	// Page is the current page number, starting at 1.
+	Page int `json:"page"`
+
+	// PerPage is the maximum number of items per page.
+	PerPage int `json:"per_page"`
+
+	// TotalPages is the total number of pages.
+	TotalPages int `json:"total_pages"`
+}
+
+// DeleteResult is the result of a Delete API method.
+type DeleteResult struct {
+	// ID is the unique identifier of the deleted resource.
+	ID string
+
+	// DeletedAt is when the client received the deletion confirmation.
+	DeletedAt time.Time
+}
+
 // formRequest is an interface that request structs can implement to use form
 // encoding instead of JSON.
 type formRequest interface {
 	form()
 }
+
+// runConcurrently runs fns concurrently, waits for all of them to return and
+// returns the first error in argument order, if any.
+func runConcurrently(fns ...func() error) error {
+	var wg sync.WaitGroup
+
+	errs := make([]error, len(fns))
+
+	for i, fn := range fns {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+			errs[i] = fn()
+		}()
+	}
+
+	wg.Wait()
+
+	for _, err := range errs {
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// runBounded runs fn for each index in [0, n) with at most limit calls in
+// flight and returns the error of each call by index. Once ctx is done, no
+// further calls are started and their error is ctx.Err(); calls in flight are
+// waited for.
+func runBounded(ctx context.Context, limit, n int, fn func(i int) error) []error {
+	var wg sync.WaitGroup
+
+	errs := make([]error, n)
+	sem := make(chan struct{}, limit)
+
+	for i := range n {
+		if err := ctx.Err(); err != nil {
+			errs[i] = err
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			errs[i] = ctx.Err()
+			continue
+		case sem <- struct{}{}:
+		}
+
+		wg.Add(1)
+
+		go func() {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+
+			errs[i] = fn(i)
+		}()
+	}
+
+	wg.Wait()
+
+	return errs
+}
@@ -4,19 +4,22 @@ import (
 	"bytes"
 	"context"
 	"crypto/rand"
+	"crypto/tls"
 	"encoding/base64"
 	"encoding/json"
 	"fmt"
 	"io"
 	"log/slog"
-	"math"
 	"net/http"
+	"net/http/httptest"
 	"os"
 	"strconv"
 	"strings"
+	"sync"
 	"testing"
 	"time"
 
+	"github.com/stretchr/testify/assert"
 	"go.charbar.io/gomts"
 	"go.charbar.io/gomts/internal/sweeper"
 )
@@ -188,13 +191,318 @@ func testClient() (gomts.Client, *gomts.Config) {
 	return gomts.NewClient(conf), conf
 }
 
-// testResourceName generates a unique-ish name for test resources so they can
-// be cleaned up later if leaked by failed test teardown.
+// testResourceNames records every name generated by testResourceName so
+// duplicates within a test run are caught.
+var testResourceNames = struct {
+	mtx   sync.Mutex
+	names map[string]struct{}
+}{names: make(map[string]struct{})}
+
+// testResourceName generates a unique name for test resources so they can be
+// cleaned up later if leaked by failed test teardown. Panics if the same name
+// is generated twice in one test run.
 //
-// format: ${PREFIX}${RANDOM_4_DIGITS}-${NAME}
+// format: ${PREFIX}${RANDOM_8_CHARS}-${NAME}
 func testResourceName(name string) string {
-	buff := make([]byte, int(math.Ceil(float64(4)/float64(1.33333333333))))
-	rand.Read(buff)
-	str := base64.RawURLEncoding.EncodeToString(buff)
-	return testResourcePrefix + str[:4] + "-" + name
+	// 6 random bytes encode to exactly 8 URL-safe base64 characters
+	buff := make([]byte, 6)
+	if _, err := rand.Read(buff); err != nil {
+		panic(fmt.Sprintf("could not generate test resource name: %v", err))
+	}
+
+	out := testResourcePrefix + base64.RawURLEncoding.EncodeToString(buff) + "-" + name
+
+	testResourceNames.mtx.Lock()
+	defer testResourceNames.mtx.Unlock()
+
+	if _, ok := testResourceNames.names[out]; ok {
+		panic(fmt.Sprintf("duplicate test resource name %q", out))
+	}
+
+	testResourceNames.names[out] = struct{}{}
+
+	return out
+}
+
+func TestForceHTTP2(t *testing.T) {
+	var proto string
+
+	srv := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		proto = r.TLS.NegotiatedProtocol
+		fmt.Fprint(w, `{"departments": []}`)
+	}))
+	srv.EnableHTTP2 = true
+	srv.StartTLS()
+	defer srv.Close()
+
+	for _, forceHTTP2 := range []bool{false, true} {
+		// a custom TLS config disables HTTP/2 unless explicitly configured
+		transport := &http.Transport{
+			TLSClientConfig: &tls.Config{
+				RootCAs: srv.Client().Transport.(*http.Transport).TLSClientConfig.RootCAs,
+			},
+		}
+
+		client := gomts.NewClient(&gomts.Config{
+			Host:       srv.Listener.Addr().String(),
+			AuthToken:  "token",
+			Transport:  transport,
+			ForceHTTP2: forceHTTP2,
+			LogHandler: new(testLogHandler),
+		})
+
+		_, err := client.Departments().List(context.Background())
+		assert.NoError(t, err)
+
+		if forceHTTP2 {
+			assert.Contains(t, transport.TLSClientConfig.NextProtos, "h2")
+			assert.Equal(t, "h2", proto)
+		} else {
+			assert.NotContains(t, transport.TLSClientConfig.NextProtos, "h2")
+			assert.NotEqual(t, "h2", proto)
+		}
+	}
+}
+
+func TestClientWithToken(t *testing.T) {
+	var tokens []string
+
+	client := fakeServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		token, _, _ := r.BasicAuth()
+		tokens = append(tokens, token)
+
+		fmt.Fprint(w, `{"departments": []}`)
+	})
+
+	ctx := context.Background()
+
+	_, err := client.WithToken("other").Departments().List(ctx)
+	assert.NoError(t, err)
+
+	_, err = client.Departments().List(ctx)
+	assert.NoError(t, err)
+
+	assert.Equal(t, []string{"other", "token"}, tokens)
+}
+
+func TestWithRequestTimeout(t *testing.T) {
+	client := fakeServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+		case <-time.After(time.Second):
+		}
+
+		fmt.Fprint(w, `{"departments": []}`)
+	})
+
+	ctx := gomts.WithRequestTimeout(context.Background(), 10*time.Millisecond)
+
+	_, err := client.Departments().List(ctx)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func TestHeaderTransformer(t *testing.T) {
+	var header http.Header
+
+	client := fakeServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		header = r.Header.Clone()
+		fmt.Fprint(w, `{"departments": []}`)
+	}, func(conf *gomts.Config) {
+		conf.HeaderTransformer = func(h http.Header) {
+			h.Set("X-Tenant", "acme")
+			h.Set("Authorization", "Bearer stolen")
+		}
+	})
+
+	_, err := client.Departments().List(context.Background())
+	assert.NoError(t, err)
+
+	assert.Equal(t, "acme", header.Get("X-Tenant"))
+
+	// the transformer cannot overwrite auth
+	assert.Equal(t, "Basic "+base64.StdEncoding.EncodeToString([]byte("token:")), header.Get("Authorization"))
+}
+
+func TestOnError(t *testing.T) {
+	type call struct {
+		err error
+		req *http.Request
+	}
+
+	var calls []call
+
+	client := fakeServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/v1.2/departments/dep_missing" {
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(w, `{"error": {"error_code": 404, "error_text": "department not found"}}`)
+			return
+		}
+
+		fmt.Fprint(w, `{"departments": []}`)
+	}, func(conf *gomts.Config) {
+		conf.OnError = func(err error, req *http.Request) {
+			calls = append(calls, call{err: err, req: req})
+		}
+	})
+
+	ctx := context.Background()
+
+	// not called for 2XX responses
+	_, err := client.Departments().List(ctx)
+	assert.NoError(t, err)
+	assert.Empty(t, calls)
+
+	_, err = client.Departments().Get(ctx, "dep_missing")
+	assert.Error(t, err)
+
+	if assert.Len(t, calls, 1) {
+		var mtsErr *gomts.Error
+		if assert.ErrorAs(t, calls[0].err, &mtsErr) {
+			assert.Equal(t, http.StatusNotFound, mtsErr.ErrorCode)
+			assert.Equal(t, "department not found", mtsErr.ErrorText)
+			assert.NotEmpty(t, mtsErr.CorrelationID)
+		}
+
+		assert.Equal(t, http.MethodGet, calls[0].req.Method)
+		assert.Equal(t, "/v1.2/departments/dep_missing", calls[0].req.URL.Path)
+	}
+}
+
+// fakeServerClient creates a client backed by an httptest.Server serving the
+// given handler. The server is closed on test clean up. Any opts are applied
+// to the config before the client is created.
+func fakeServerClient(t *testing.T, handler http.HandlerFunc, opts ...func(*gomts.Config)) gomts.Client {
+	srv := httptest.NewServer(handler)
+	t.Cleanup(srv.Close)
+
+	conf := &gomts.Config{
+		Protocol:   "http",
+		Host:       srv.Listener.Addr().String(),
+		AuthToken:  "token",
+		LogHandler: new(testLogHandler),
+	}
+
+	for _, opt := range opts {
+		opt(conf)
+	}
+
+	return gomts.NewClient(conf)
+}
+
+func TestStrictJSON(t *testing.T) {
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"departments": [{"department_id": "dep_1", "unknown_field": true}]}`)
+	}
+
+	ctx := context.Background()
+
+	departments, err := fakeServerClient(t, handler).Departments().List(ctx)
+	if assert.NoError(t, err) && assert.Len(t, departments, 1) {
+		assert.Equal(t, "dep_1", departments[0].ID)
+	}
+
+	var hookCalled bool
+
+	client := fakeServerClient(t, handler, func(conf *gomts.Config) {
+		conf.StrictJSON = true
+		conf.JSONDecodeHook = func(dec *json.Decoder) {
+			hookCalled = true
+		}
+	})
+
+	_, err = client.Departments().List(ctx)
+	assert.ErrorContains(t, err, `unknown field "unknown_field"`)
+	assert.True(t, hookCalled)
+}
+
+func TestLogLevel(t *testing.T) {
+	ctx := context.Background()
+
+	for _, tc := range []struct {
+		name  string
+		conf  *gomts.Config
+		level slog.Level
+	}{
+		{name: "default", conf: &gomts.Config{}, level: slog.LevelInfo},
+		{name: "warn", conf: &gomts.Config{LogLevel: slog.LevelWarn}, level: slog.LevelWarn},
+		{name: "debug", conf: &gomts.Config{Debug: true}, level: slog.LevelDebug},
+		{name: "debug overrides", conf: &gomts.Config{Debug: true, LogLevel: slog.LevelError}, level: slog.LevelDebug},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			logr := tc.conf.GetLogger()
+
+			assert.True(t, logr.Enabled(ctx, tc.level))
+			assert.False(t, logr.Enabled(ctx, tc.level-1))
+		})
+	}
+}
+
+func TestDebugLogLevelWarning(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		logLevel slog.Leveler
+		warned   bool
+	}{
+		{name: "unset", logLevel: nil, warned: false},
+		{name: "info", logLevel: slog.LevelInfo, warned: true},
+		{name: "warn", logLevel: slog.LevelWarn, warned: true},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			var logs bytes.Buffer
+
+			client := gomts.NewClient(&gomts.Config{
+				AuthToken:  "token",
+				Debug:      true,
+				LogLevel:   tc.logLevel,
+				LogHandler: slog.NewTextHandler(&logs, nil),
+			})
+
+			_ = client.WithToken("other")
+
+			count := strings.Count(logs.String(), "debug takes precedence")
+
+			if tc.warned {
+				// logged once, not again for the derived client
+				assert.Equal(t, 1, count)
+			} else {
+				assert.Zero(t, count)
+			}
+		})
+	}
+}
+
+func TestWithLogger(t *testing.T) {
+	var clientLogs, ctxLogs bytes.Buffer
+
+	debugHandler := func(w io.Writer) slog.Handler {
+		return slog.NewTextHandler(w, &slog.HandlerOptions{Level: slog.LevelDebug})
+	}
+
+	client := fakeServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"departments": []}`)
+	}, func(conf *gomts.Config) {
+		conf.Debug = true
+		conf.LogHandler = debugHandler(&clientLogs)
+	})
+
+	ctxLogger := slog.New(debugHandler(&ctxLogs))
+
+	assert.Nil(t, gomts.LoggerFromContext(context.Background()))
+
+	ctx := gomts.WithLogger(context.Background(), ctxLogger)
+	assert.Same(t, ctxLogger, gomts.LoggerFromContext(ctx))
+
+	_, err := client.Departments().List(ctx)
+	assert.NoError(t, err)
+
+	// the context logger replaces the client logger for the dumps
+	assert.Contains(t, ctxLogs.String(), "outbound request")
+	assert.Contains(t, ctxLogs.String(), "received response")
+	assert.NotContains(t, clientLogs.String(), "outbound request")
+
+	_, err = client.Departments().List(context.Background())
+	assert.NoError(t, err)
+
+	assert.Contains(t, clientLogs.String(), "outbound request")
+	assert.Contains(t, clientLogs.String(), "received response")
 }
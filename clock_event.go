@@ -0,0 +1,136 @@
+package gomts
+
+import (
+	"context"
+	"slices"
+	"time"
+)
+
+// ClockEventClient lists the clock events of employees. The MyTimeStation API
+// does not document an endpoint for punch history, so clock events are
+// supplied by Config.ClockEventSource, e.g. with NewClockEventSource.
+type ClockEventClient interface {
+	// List clock events matching the request filters. A nil request lists all
+	// clock events.
+	List(ctx context.Context, req *ClockEventListRequest) ([]ClockEvent, error)
+}
+
+// ClockMethod represents how a clock event was triggered.
+type ClockMethod string
+
+const (
+	// ClockMethodCard signals the employee swiped their card.
+	ClockMethodCard ClockMethod = "card"
+
+	// ClockMethodPIN signals the employee entered their PIN.
+	ClockMethodPIN ClockMethod = "pin"
+
+	// ClockMethodQR signals the employee scanned their QR code.
+	ClockMethodQR ClockMethod = "qr"
+
+	// ClockMethodManual signals a manager manually clocked the employee.
+	ClockMethodManual ClockMethod = "manual"
+
+	// ClockMethodApp signals the employee clocked using the mobile app.
+	ClockMethodApp ClockMethod = "app"
+)
+
+// ClockEvent represents a single punch of an employee clocking in or out in
+// the MyTimeStation system.
+type ClockEvent struct {
+	// ID is the unique identifier for the clock event within the
+	// MyTimeStation system.
+	ID string `json:"clock_event_id"`
+
+	// EmployeeID is the unique identifier of the employee who punched.
+	EmployeeID string `json:"employee_id"`
+
+	// DepartmentID is the unique identifier of the department punched into.
+	DepartmentID string `json:"department_id"`
+
+	// Timestamp is when the punch occurred.
+	Timestamp time.Time `json:"timestamp"`
+
+	// Direction is the status the employee punched into.
+	Direction EmployeeStatus `json:"direction"`
+
+	// Method is how the punch was triggered.
+	Method ClockMethod `json:"method"`
+}
+
+// ClockEventListRequest represents the query parameters to filter clock
+// events. All fields are optional.
+type ClockEventListRequest struct {
+	// EmployeeID filters to clock events of the employee.
+	EmployeeID string
+
+	// Start filters to clock events at or after Start.
+	Start time.Time
+
+	// End filters to clock events before End.
+	End time.Time
+
+	// Methods filters to clock events triggered by any of the methods.
+	Methods []ClockMethod
+}
+
+// matches reports whether event passes the filters of r.
+func (r *ClockEventListRequest) matches(event ClockEvent) bool {
+	if r.EmployeeID != "" && event.EmployeeID != r.EmployeeID {
+		return false
+	}
+
+	if !r.Start.IsZero() && event.Timestamp.Before(r.Start) {
+		return false
+	}
+
+	if !r.End.IsZero() && !event.Timestamp.Before(r.End) {
+		return false
+	}
+
+	return len(r.Methods) == 0 || slices.Contains(r.Methods, event.Method)
+}
+
+// NewClockEventSource returns a ClockEventClient listing from events, e.g.
+// loaded from a MyTimeStation export, for use as Config.ClockEventSource.
+// Request filters are applied client-side. events is copied.
+func NewClockEventSource(events []ClockEvent) ClockEventClient {
+	return &staticClockEventClient{events: slices.Clone(events)}
+}
+
+// staticClockEventClient implements ClockEventClient.
+type staticClockEventClient struct {
+	events []ClockEvent
+}
+
+func (c *staticClockEventClient) List(ctx context.Context, req *ClockEventListRequest) ([]ClockEvent, error) {
+	if req == nil {
+		return slices.Clone(c.events), nil
+	}
+
+	var out []ClockEvent
+
+	for _, event := range c.events {
+		if req.matches(event) {
+			out = append(out, event)
+		}
+	}
+
+	return out, nil
+}
+
+// unsupportedClockEventClient implements ClockEventClient for clients without
+// a Config.ClockEventSource.
+type unsupportedClockEventClient struct{}
+
+func (unsupportedClockEventClient) List(ctx context.Context, req *ClockEventListRequest) ([]ClockEvent, error) {
+	return nil, ErrNotImplemented
+}
+
+// compile-time assertion that staticClockEventClient implementation fulfils
+// ClockEventClient interface.
+var _ ClockEventClient = (*staticClockEventClient)(nil)
+
+// compile-time assertion that unsupportedClockEventClient implementation
+// fulfils ClockEventClient interface.
+var _ ClockEventClient = unsupportedClockEventClient{}
@@ -0,0 +1,134 @@
+package gomts_test
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.charbar.io/gomts"
+)
+
+// recordingClockEvents wraps a ClockEventClient, recording the requests made
+// to it.
+type recordingClockEvents struct {
+	gomts.ClockEventClient
+
+	// mtx protects the following resources
+	mtx      sync.Mutex
+	requests []gomts.ClockEventListRequest
+}
+
+func (r *recordingClockEvents) List(ctx context.Context, req *gomts.ClockEventListRequest) ([]gomts.ClockEvent, error) {
+	r.mtx.Lock()
+	if req != nil {
+		r.requests = append(r.requests, *req)
+	}
+	r.mtx.Unlock()
+
+	return r.ClockEventClient.List(ctx, req)
+}
+
+// withClockEvents returns a config option setting a ClockEventSource of events.
+func withClockEvents(events ...gomts.ClockEvent) func(*gomts.Config) {
+	return func(conf *gomts.Config) {
+		conf.ClockEventSource = gomts.NewClockEventSource(events)
+	}
+}
+
+// punch builds a clock event of an employee.
+func punch(employeeID string, direction gomts.EmployeeStatus, timestamp time.Time) gomts.ClockEvent {
+	return gomts.ClockEvent{EmployeeID: employeeID, Direction: direction, Timestamp: timestamp}
+}
+
+func TestNewClockEventSource(t *testing.T) {
+	at := func(hour int) time.Time {
+		return time.Date(2024, time.January, 1, hour, 0, 0, 0, time.UTC)
+	}
+
+	events := []gomts.ClockEvent{
+		{ID: "evt_1", EmployeeID: "emp_1", Timestamp: at(8), Method: gomts.ClockMethodPIN},
+		{ID: "evt_2", EmployeeID: "emp_1", Timestamp: at(9), Method: gomts.ClockMethodCard},
+		{ID: "evt_3", EmployeeID: "emp_1", Timestamp: at(10), Method: gomts.ClockMethodQR},
+		{ID: "evt_4", EmployeeID: "emp_2", Timestamp: at(9), Method: gomts.ClockMethodPIN},
+		{ID: "evt_5", EmployeeID: "emp_1", Timestamp: at(12), Method: gomts.ClockMethodPIN},
+	}
+
+	source := gomts.NewClockEventSource(events)
+
+	// changes by the caller are not seen by the source
+	events[0].ID = "evt_0"
+
+	ctx := context.Background()
+
+	for _, tc := range []struct {
+		name string
+		req  *gomts.ClockEventListRequest
+		want []string
+	}{
+		{name: "all", req: nil, want: []string{"evt_1", "evt_2", "evt_3", "evt_4", "evt_5"}},
+		{name: "employee", req: &gomts.ClockEventListRequest{EmployeeID: "emp_2"}, want: []string{"evt_4"}},
+		{
+			name: "range",
+			req:  &gomts.ClockEventListRequest{EmployeeID: "emp_1", Start: at(9), End: at(12)},
+			want: []string{"evt_2", "evt_3"},
+		},
+		{
+			name: "methods",
+			req:  &gomts.ClockEventListRequest{Methods: []gomts.ClockMethod{gomts.ClockMethodCard, gomts.ClockMethodQR}},
+			want: []string{"evt_2", "evt_3"},
+		},
+		{name: "none", req: &gomts.ClockEventListRequest{EmployeeID: "emp_3"}, want: nil},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			events, err := source.List(ctx, tc.req)
+			assert.NoError(t, err)
+
+			var ids []string
+			for _, event := range events {
+				ids = append(ids, event.ID)
+			}
+
+			assert.Equal(t, tc.want, ids)
+		})
+	}
+}
+
+func TestClockEventsWithoutSource(t *testing.T) {
+	client := fakeServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request to %s", r.URL.Path)
+	})
+
+	_, err := client.ClockEvents().List(context.Background(), nil)
+	assert.ErrorIs(t, err, gomts.ErrNotImplemented)
+
+	_, err = client.Employees().GetMostRecentPunch(context.Background(), "emp_1")
+	assert.ErrorIs(t, err, gomts.ErrNotImplemented)
+}
+
+func TestEmployeesGetMostRecentPunch(t *testing.T) {
+	client := fakeServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request to %s", r.URL.Path)
+	}, withClockEvents(
+		gomts.ClockEvent{ID: "evt_2", EmployeeID: "emp_1", Timestamp: time.Date(2024, time.January, 2, 9, 0, 0, 0, time.UTC)},
+		gomts.ClockEvent{ID: "evt_3", EmployeeID: "emp_1", Timestamp: time.Date(2024, time.January, 3, 9, 0, 0, 0, time.UTC)},
+		gomts.ClockEvent{ID: "evt_1", EmployeeID: "emp_1", Timestamp: time.Date(2024, time.January, 1, 9, 0, 0, 0, time.UTC)},
+		gomts.ClockEvent{ID: "evt_4", EmployeeID: "emp_3", Timestamp: time.Date(2024, time.January, 4, 9, 0, 0, 0, time.UTC)},
+	))
+
+	ctx := context.Background()
+
+	event, err := client.Employees().GetMostRecentPunch(ctx, "emp_1")
+	assert.NoError(t, err)
+	assert.Equal(t, "evt_3", event.ID)
+
+	_, err = client.Employees().GetMostRecentPunch(ctx, "emp_2")
+
+	var mtsErr *gomts.Error
+	if assert.ErrorAs(t, err, &mtsErr) {
+		assert.Equal(t, http.StatusNotFound, mtsErr.ErrorCode)
+		assert.Equal(t, "no punch history found", mtsErr.ErrorText)
+	}
+}
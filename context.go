@@ -0,0 +1,39 @@
+package gomts
+
+import (
+	"context"
+	"log/slog"
+	"time"
+)
+
+// contextLoggerKey is the context key for a per-request *slog.Logger.
+type contextLoggerKey struct{}
+
+// WithLogger returns a copy of ctx carrying l. Requests made with the returned
+// context are logged with l instead of the client's logger.
+func WithLogger(ctx context.Context, l *slog.Logger) context.Context {
+	return context.WithValue(ctx, contextLoggerKey{}, l)
+}
+
+// LoggerFromContext returns the logger carried by ctx, or nil if there is
+// none.
+func LoggerFromContext(ctx context.Context) *slog.Logger {
+	logr, _ := ctx.Value(contextLoggerKey{}).(*slog.Logger)
+	return logr
+}
+
+// contextRequestTimeoutKey is the context key for a per-request timeout.
+type contextRequestTimeoutKey struct{}
+
+// WithRequestTimeout returns a copy of ctx carrying the timeout d. Each request
+// made with the returned context times out after d. Methods which make several
+// requests apply d to each of them rather than in total.
+func WithRequestTimeout(ctx context.Context, d time.Duration) context.Context {
+	return context.WithValue(ctx, contextRequestTimeoutKey{}, d)
+}
+
+// requestTimeoutFromContext returns the request timeout carried by ctx, if any.
+func requestTimeoutFromContext(ctx context.Context) (time.Duration, bool) {
+	d, ok := ctx.Value(contextRequestTimeoutKey{}).(time.Duration)
+	return d, ok
+}
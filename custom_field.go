@@ -0,0 +1,72 @@
+package gomts
+
+import (
+	"fmt"
+	"net/url"
+	"reflect"
+	"slices"
+	"strconv"
+)
+
+// FormCustomFields are custom fields sent with a form request, encoded as
+// custom_fields[key]=value.
+type FormCustomFields map[string]string
+
+// EncodeValues implements query.Encoder for form requests.
+func (f FormCustomFields) EncodeValues(key string, v *url.Values) error {
+	for name, value := range f {
+		v.Set(key+"["+name+"]", value)
+	}
+
+	return nil
+}
+
+// CustomFieldsFromMap converts the values of m to strings for use as
+// CustomFields, e.g. when decoded from user input or a config file. Strings,
+// booleans, numbers and fmt.Stringer implementations are supported; any other
+// value, such as a nested map, slice or nil, fails with a *ValidationError.
+func CustomFieldsFromMap(m map[string]interface{}) (map[string]string, error) {
+	out := make(map[string]string, len(m))
+
+	// iterate in order so the same invalid field is always reported
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+
+	slices.Sort(keys)
+
+	for _, key := range keys {
+		value, err := customFieldValue(m[key])
+		if err != nil {
+			return nil, &ValidationError{Field: "custom_fields." + key, Reason: err.Error()}
+		}
+
+		out[key] = value
+	}
+
+	return out, nil
+}
+
+// customFieldValue converts v to its string representation.
+func customFieldValue(v any) (string, error) {
+	if s, ok := v.(fmt.Stringer); ok {
+		return s.String(), nil
+	}
+
+	rv := reflect.ValueOf(v)
+
+	switch rv.Kind() {
+	case reflect.String:
+		return rv.String(), nil
+	case reflect.Bool,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return fmt.Sprintf("%v", v), nil
+	case reflect.Float32, reflect.Float64:
+		// avoid exponent notation for large values
+		return strconv.FormatFloat(rv.Float(), 'f', -1, rv.Type().Bits()), nil
+	}
+
+	return "", fmt.Errorf("%T cannot be represented as a string", v)
+}
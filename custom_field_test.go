@@ -0,0 +1,34 @@
+package gomts_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.charbar.io/gomts"
+)
+
+func TestCustomFieldsFromMap(t *testing.T) {
+	fields, err := gomts.CustomFieldsFromMap(map[string]interface{}{
+		"phone":   "555-0100",
+		"manager": true,
+		"level":   3,
+		"rate":    1234567.5,
+	})
+	assert.NoError(t, err)
+
+	assert.Equal(t, map[string]string{
+		"phone":   "555-0100",
+		"manager": "true",
+		"level":   "3",
+		"rate":    "1234567.5",
+	}, fields)
+
+	for _, value := range []interface{}{nil, []string{"a"}, map[string]string{}, struct{}{}} {
+		_, err := gomts.CustomFieldsFromMap(map[string]interface{}{"bad": value})
+
+		var validationErr *gomts.ValidationError
+		if assert.ErrorAs(t, err, &validationErr, "%#v", value) {
+			assert.Equal(t, "custom_fields.bad", validationErr.Field)
+		}
+	}
+}
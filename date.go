@@ -0,0 +1,68 @@
+package gomts
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"time"
+)
+
+// dateLayout is the layout of dates in the MyTimeStation API.
+const dateLayout = "2006-01-02"
+
+// Date represents a calendar date, encoded in the YYYY-MM-DD format used by
+// the MyTimeStation API. The zero Date encodes as null.
+type Date struct {
+	time.Time
+}
+
+// NewDate returns the Date of t, discarding the time of day.
+func NewDate(t time.Time) *Date {
+	return &Date{time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)}
+}
+
+// String implements fmt.Stringer.
+func (d Date) String() string {
+	return d.Format(dateLayout)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (d Date) MarshalJSON() ([]byte, error) {
+	if d.IsZero() {
+		return []byte("null"), nil
+	}
+
+	return json.Marshal(d.String())
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (d *Date) UnmarshalJSON(b []byte) error {
+	var s *string
+
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+
+	if s == nil || *s == "" {
+		*d = Date{}
+		return nil
+	}
+
+	t, err := time.Parse(dateLayout, *s)
+	if err != nil {
+		return fmt.Errorf("could not parse date: %w", err)
+	}
+
+	*d = Date{t}
+
+	return nil
+}
+
+// EncodeValues implements query.Encoder for form requests.
+func (d Date) EncodeValues(key string, v *url.Values) error {
+	if !d.IsZero() {
+		v.Set(key, d.String())
+	}
+
+	return nil
+}
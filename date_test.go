@@ -0,0 +1,31 @@
+package gomts_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/google/go-querystring/query"
+	"github.com/stretchr/testify/assert"
+	"go.charbar.io/gomts"
+)
+
+func TestDate(t *testing.T) {
+	date := gomts.NewDate(time.Date(2024, time.March, 9, 17, 30, 0, 0, time.Local))
+
+	b, err := json.Marshal(&gomts.EmployeeUpdateRequest{StartDate: date})
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"start_date": "2024-03-09"}`, string(b))
+
+	var employee gomts.Employee
+	assert.NoError(t, json.Unmarshal([]byte(`{"start_date": "2024-03-09"}`), &employee))
+	assert.Equal(t, date, employee.StartDate)
+
+	values, err := query.Values(&gomts.EmployeeCreateRequest{Name: "bob ross", StartDate: date})
+	assert.NoError(t, err)
+	assert.Equal(t, "2024-03-09", values.Get("start_date"))
+
+	b, err = json.Marshal(new(gomts.EmployeeUpdateRequest).Clear())
+	assert.NoError(t, err)
+	assert.Contains(t, string(b), `"start_date":null`)
+}
@@ -1,6 +1,11 @@
 package gomts
 
-import "context"
+import (
+	"context"
+	"net/http"
+	"strings"
+	"time"
+)
 
 // DepartmentClient interfaces with Department related MyTimeStation API
 // methods.
@@ -8,13 +13,45 @@ type DepartmentClient interface {
 	// Create a new department.
 	Create(ctx context.Context, req *DepartmentCreateRequest) (*Department, error)
 
+	// CreateWithEmployees creates a new department and assigns it as the
+	// primary department of the given employees.
+	CreateWithEmployees(ctx context.Context, req *DepartmentCreateRequest, employeeIDs []string) (*Department, error)
+
+	// Get a department with full details.
+	Get(ctx context.Context, id string) (*Department, error)
+
+	// GetManager gets the employee managing the given department.
+	GetManager(ctx context.Context, departmentID string) (*Employee, error)
+
 	List(ctx context.Context) ([]Department, error)
 
-	Delete(ctx context.Context, id string) (*Department, error)
+	Delete(ctx context.Context, id string) (*DeleteResult, error)
+
+	// GetByName gets a department by its exact name.
+	GetByName(ctx context.Context, name string) (*Department, error)
+
+	// ListEmployees lists all employees whose primary department is the given
+	// department. Equivalent to EmployeeClient.ListByDepartment.
+	ListEmployees(ctx context.Context, departmentID string) ([]Employee, error)
+
+	// ListEmployeesByStatus lists all employees whose primary department is
+	// the given department and who have the given clock status.
+	ListEmployeesByStatus(ctx context.Context, departmentID string, status EmployeeStatus) ([]Employee, error)
+
+	// Count the number of departments.
+	Count(ctx context.Context) (int, error)
+
+	// Merge moves all employees whose primary department is the source
+	// department to the target department, then deletes the source department.
+	// Returns the updated target department. Employees with the source as a
+	// secondary department are not moved.
+	Merge(ctx context.Context, sourceID, targetID string) (*Department, error)
 }
 
 // Department represents a department at a customer company in the
 // MyTimeStation system.
+//
+// List only populates ID and Name. Get populates all fields.
 type Department struct {
 	// ID is the unique identifier for the department within the MyTimeStation
 	// system.
@@ -22,6 +59,25 @@ type Department struct {
 
 	// Name is the name of the department.
 	Name string `json:"name"`
+
+	// ParentID is the unique identifier of the parent department, if any. The
+	// MyTimeStation API does not support nested departments yet, so it is
+	// always empty; see BuildDepartmentTree.
+	ParentID string `json:"parent_department_id,omitempty"`
+
+	// ManagerEmployeeID is the unique identifier of the employee managing the
+	// department, if any.
+	ManagerEmployeeID string `json:"manager_employee_id,omitempty"`
+
+	// EmployeeCount is the number of employees whose primary department is
+	// this department.
+	EmployeeCount int `json:"employee_count"`
+
+	// CreatedAt is when the department was created.
+	CreatedAt time.Time `json:"created_at"`
+
+	// UpdatedAt is when the department was last updated.
+	UpdatedAt time.Time `json:"updated_at"`
 }
 
 type DepartmentCreateRequest struct {
@@ -33,6 +89,15 @@ type DepartmentCreateRequest struct {
 // form implements formRequest.
 func (DepartmentCreateRequest) form() {}
 
+// Validate returns a *ValidationError if the request is invalid.
+func (r *DepartmentCreateRequest) Validate() error {
+	if strings.TrimSpace(r.Name) == "" {
+		return &ValidationError{Field: "name", Reason: "is required"}
+	}
+
+	return nil
+}
+
 // DepartmentListResponse is the response used for the List API method.
 type DepartmentListResponse struct {
 	// Departments is the list of departments
@@ -52,6 +117,10 @@ type departmentClient struct {
 }
 
 func (c *departmentClient) Create(ctx context.Context, req *DepartmentCreateRequest) (*Department, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
 	resp, err := httpPost[DepartmentResponse](ctx, c.client, "/departments", req)
 	if err != nil {
 		return nil, err
@@ -60,6 +129,53 @@ func (c *departmentClient) Create(ctx context.Context, req *DepartmentCreateRequ
 	return &resp.Department, nil
 }
 
+// CreateWithEmployees creates the department then updates each employee
+// concurrently, bounded by Config.BatchConcurrency, as the MyTimeStation API
+// cannot assign employees on create. If any update fails, the created
+// department is returned alongside an ErrorList.
+func (c *departmentClient) CreateWithEmployees(ctx context.Context, req *DepartmentCreateRequest, employeeIDs []string) (*Department, error) {
+	department, err := c.Create(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	errs := runBounded(ctx, c.conf.GetBatchConcurrency(), len(employeeIDs), func(i int) error {
+		_, err := c.employees.Update(ctx, employeeIDs[i], &EmployeeUpdateRequest{
+			DepartmentID: &department.ID,
+		})
+		return err
+	})
+
+	if errList := collectEmployeeErrors(errs, employeeIDs); len(errList) > 0 {
+		return department, errList
+	}
+
+	return department, nil
+}
+
+func (c *departmentClient) Get(ctx context.Context, id string) (*Department, error) {
+	resp, err := httpGet[DepartmentResponse](ctx, c.client, "/departments/"+id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &resp.Department, nil
+}
+
+// GetManager returns a 404 *Error if the department has no manager assigned.
+func (c *departmentClient) GetManager(ctx context.Context, departmentID string) (*Employee, error) {
+	department, err := c.Get(ctx, departmentID)
+	if err != nil {
+		return nil, err
+	}
+
+	if department.ManagerEmployeeID == "" {
+		return nil, &Error{ErrorCode: http.StatusNotFound, ErrorText: "department has no manager"}
+	}
+
+	return c.employees.Get(ctx, department.ManagerEmployeeID)
+}
+
 func (c *departmentClient) List(ctx context.Context) ([]Department, error) {
 	resp, err := httpGet[DepartmentListResponse](ctx, c.client, "/departments")
 	if err != nil {
@@ -69,13 +185,105 @@ func (c *departmentClient) List(ctx context.Context) ([]Department, error) {
 	return resp.Departments, nil
 }
 
-func (c *departmentClient) Delete(ctx context.Context, id string) (*Department, error) {
+func (c *departmentClient) Delete(ctx context.Context, id string) (*DeleteResult, error) {
 	resp, err := httpDelete[DepartmentResponse](ctx, c.client, "/departments/"+id)
 	if err != nil {
 		return nil, err
 	}
 
-	return &resp.Department, nil
+	return &DeleteResult{ID: resp.Department.ID, DeletedAt: time.Now()}, nil
+}
+
+// GetByName filters client-side as the MyTimeStation API does not support
+// looking up departments by name. Returns a 404 *Error if none match.
+func (c *departmentClient) GetByName(ctx context.Context, name string) (*Department, error) {
+	departments, err := c.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, department := range departments {
+		if department.Name == name {
+			return &department, nil
+		}
+	}
+
+	return nil, &Error{ErrorCode: http.StatusNotFound, ErrorText: "department not found"}
+}
+
+func (c *departmentClient) ListEmployees(ctx context.Context, departmentID string) ([]Employee, error) {
+	return c.employees.ListByDepartment(ctx, departmentID)
+}
+
+// ListEmployeesByStatus filters client-side as the MyTimeStation API does not
+// support filtering employees by department or status.
+func (c *departmentClient) ListEmployeesByStatus(ctx context.Context, departmentID string, status EmployeeStatus) ([]Employee, error) {
+	if err := status.Validate(); err != nil {
+		return nil, err
+	}
+
+	employees, err := c.ListEmployees(ctx, departmentID)
+	if err != nil {
+		return nil, err
+	}
+
+	var out []Employee
+
+	for _, employee := range employees {
+		if employee.Status == status {
+			out = append(out, employee)
+		}
+	}
+
+	return out, nil
+}
+
+func (c *departmentClient) Count(ctx context.Context) (int, error) {
+	departments, err := c.List(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	return len(departments), nil
+}
+
+// Merge moves employees concurrently, bounded by Config.BatchConcurrency. If
+// any move fails, the source department is not deleted and an ErrorList is
+// returned, so the merge can be retried.
+func (c *departmentClient) Merge(ctx context.Context, sourceID, targetID string) (*Department, error) {
+	if sourceID == targetID {
+		return nil, &ValidationError{Field: "target_id", Reason: "must differ from source_id"}
+	}
+
+	// ensure the target exists before moving any employees
+	if _, err := c.Get(ctx, targetID); err != nil {
+		return nil, err
+	}
+
+	employees, err := c.ListEmployees(ctx, sourceID)
+	if err != nil {
+		return nil, err
+	}
+
+	errs := runBounded(ctx, c.conf.GetBatchConcurrency(), len(employees), func(i int) error {
+		_, err := c.employees.SetDepartment(ctx, employees[i].ID, targetID, false)
+		return err
+	})
+
+	ids := make([]string, len(employees))
+	for i, employee := range employees {
+		ids[i] = employee.ID
+	}
+
+	if errList := collectEmployeeErrors(errs, ids); len(errList) > 0 {
+		return nil, errList
+	}
+
+	if _, err := c.Delete(ctx, sourceID); err != nil {
+		return nil, err
+	}
+
+	return c.Get(ctx, targetID)
 }
 
 // compile-time assertion that departmentClient implementation fulfils
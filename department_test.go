@@ -1 +1,354 @@
-package gomts
+package gomts_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.charbar.io/gomts"
+)
+
+func TestDepartmentsCreateValidation(t *testing.T) {
+	client, _ := testClient()
+
+	_, err := client.Departments().Create(context.Background(), &gomts.DepartmentCreateRequest{Name: " "})
+
+	var validationErr *gomts.ValidationError
+	assert.ErrorAs(t, err, &validationErr)
+	assert.Equal(t, "name", validationErr.Field)
+}
+
+func TestDepartmentsCreate(t *testing.T) {
+	client, _ := integrationTest(t)
+
+	ctx := context.Background()
+
+	createRequest := &gomts.DepartmentCreateRequest{
+		Name: testResourceName("something"),
+	}
+
+	dept, err := client.Departments().Create(ctx, createRequest)
+	assert.NoError(t, err)
+
+	assert.NotEmpty(t, dept.ID)
+	assert.Equal(t, createRequest.Name, dept.Name)
+}
+
+func TestDepartmentsList(t *testing.T) {
+	client, _ := integrationTest(t)
+
+	ctx := context.Background()
+
+	dept, err := client.Departments().Create(ctx, &gomts.DepartmentCreateRequest{
+		Name: testResourceName("something"),
+	})
+	assert.NoError(t, err)
+
+	departments, err := client.Departments().List(ctx)
+	assert.NoError(t, err)
+
+	assert.Contains(t, departmentIDsAndNames(departments), departmentIDAndName(*dept))
+}
+
+func TestDepartmentsGet(t *testing.T) {
+	client, _ := integrationTest(t)
+
+	ctx := context.Background()
+
+	dept, err := client.Departments().Create(ctx, &gomts.DepartmentCreateRequest{
+		Name: testResourceName("something"),
+	})
+	assert.NoError(t, err)
+
+	found, err := client.Departments().Get(ctx, dept.ID)
+	assert.NoError(t, err)
+
+	assert.Equal(t, dept.ID, found.ID)
+	assert.Equal(t, dept.Name, found.Name)
+	assert.Zero(t, found.EmployeeCount)
+	assert.False(t, found.CreatedAt.IsZero())
+}
+
+func TestDepartmentsGetManager(t *testing.T) {
+	client := fakeServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/v1.2/departments/dep_1":
+			fmt.Fprint(w, `{"department": {"department_id": "dep_1", "manager_employee_id": "emp_1"}}`)
+		case "/v1.2/departments/dep_2":
+			fmt.Fprint(w, `{"department": {"department_id": "dep_2"}}`)
+		case "/v1.2/employees/emp_1":
+			fmt.Fprint(w, `{"employee": {"employee_id": "emp_1", "name": "Bob Ross"}}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	ctx := context.Background()
+
+	manager, err := client.Departments().GetManager(ctx, "dep_1")
+	assert.NoError(t, err)
+	assert.Equal(t, "emp_1", manager.ID)
+
+	_, err = client.Departments().GetManager(ctx, "dep_2")
+
+	var mtsErr *gomts.Error
+	assert.ErrorAs(t, err, &mtsErr)
+	assert.Equal(t, http.StatusNotFound, mtsErr.ErrorCode)
+}
+
+func TestDepartmentsDelete(t *testing.T) {
+	client, _ := integrationTest(t)
+
+	ctx := context.Background()
+
+	dept, err := client.Departments().Create(ctx, &gomts.DepartmentCreateRequest{
+		Name: testResourceName("something"),
+	})
+	assert.NoError(t, err)
+
+	result, err := client.Departments().Delete(ctx, dept.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, dept.ID, result.ID)
+
+	departments, err := client.Departments().List(ctx)
+	assert.NoError(t, err)
+
+	assert.NotContains(t, departmentIDsAndNames(departments), departmentIDAndName(*dept))
+}
+
+func TestDepartmentsGetByName(t *testing.T) {
+	client, _ := integrationTest(t)
+
+	ctx := context.Background()
+
+	dept, err := client.Departments().Create(ctx, &gomts.DepartmentCreateRequest{
+		Name: testResourceName("something"),
+	})
+	assert.NoError(t, err)
+
+	found, err := client.Departments().GetByName(ctx, dept.Name)
+	assert.NoError(t, err)
+	assert.Equal(t, departmentIDAndName(*dept), departmentIDAndName(*found))
+
+	_, err = client.Departments().GetByName(ctx, testResourceName("missing"))
+
+	var mtsErr *gomts.Error
+	assert.ErrorAs(t, err, &mtsErr)
+	assert.Equal(t, http.StatusNotFound, mtsErr.ErrorCode)
+}
+
+func TestDepartmentsMerge(t *testing.T) {
+	client, _ := integrationTest(t)
+
+	ctx := context.Background()
+
+	source, err := client.Departments().Create(ctx, &gomts.DepartmentCreateRequest{
+		Name: testResourceName("source"),
+	})
+	assert.NoError(t, err)
+
+	target, err := client.Departments().Create(ctx, &gomts.DepartmentCreateRequest{
+		Name: testResourceName("target"),
+	})
+	assert.NoError(t, err)
+
+	employee, err := client.Employees().Create(ctx, &gomts.EmployeeCreateRequest{
+		Name:         testResourceName("bob ross"),
+		DepartmentID: source.ID,
+	})
+	assert.NoError(t, err)
+
+	merged, err := client.Departments().Merge(ctx, source.ID, target.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, target.ID, merged.ID)
+
+	employee, err = client.Employees().Get(ctx, employee.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, target.ID, employee.PrimaryDepartmentID)
+
+	_, err = client.Departments().Get(ctx, source.ID)
+
+	var mtsErr *gomts.Error
+	assert.ErrorAs(t, err, &mtsErr)
+	assert.Equal(t, http.StatusNotFound, mtsErr.ErrorCode)
+}
+
+func TestDepartmentsCreateWithEmployeesErrors(t *testing.T) {
+	client := fakeServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/v1.2/departments":
+			fmt.Fprint(w, `{"department": {"department_id": "dep_1", "name": "painters"}}`)
+		case "/v1.2/employees/emp_2":
+			w.WriteHeader(http.StatusNotFound)
+		default:
+			fmt.Fprint(w, `{"employee": {}}`)
+		}
+	})
+
+	dept, err := client.Departments().CreateWithEmployees(context.Background(), &gomts.DepartmentCreateRequest{
+		Name: "painters",
+	}, []string{"emp_1", "emp_2", "emp_3"})
+
+	// the created department is returned alongside the failures
+	assert.Equal(t, "dep_1", dept.ID)
+
+	var errList gomts.ErrorList
+	if assert.ErrorAs(t, err, &errList) && assert.Len(t, errList, 1) {
+		assert.ErrorContains(t, errList[0], `employee "emp_2"`)
+
+		var mtsErr *gomts.Error
+		assert.ErrorAs(t, errList[0], &mtsErr)
+	}
+}
+
+func TestDepartmentsMergeErrors(t *testing.T) {
+	var deleted bool
+
+	client := fakeServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/v1.2/departments/dep_2":
+			fmt.Fprint(w, `{"department": {"department_id": "dep_2"}}`)
+		case r.URL.Path == "/v1.2/employees":
+			fmt.Fprint(w, `{"employees": [
+				{"employee_id": "emp_1", "primary_department_id": "dep_1"},
+				{"employee_id": "emp_2", "primary_department_id": "dep_1"}
+			]}`)
+		case r.URL.Path == "/v1.2/employees/emp_1" && r.Method == http.MethodPut:
+			w.WriteHeader(http.StatusBadRequest)
+		case r.Method == http.MethodDelete:
+			deleted = true
+			fmt.Fprint(w, `{"department": {"department_id": "dep_1"}}`)
+		default:
+			fmt.Fprint(w, `{"employee": {"primary_department_id": "dep_1"}}`)
+		}
+	})
+
+	_, err := client.Departments().Merge(context.Background(), "dep_1", "dep_2")
+
+	var errList gomts.ErrorList
+	if assert.ErrorAs(t, err, &errList) && assert.Len(t, errList, 1) {
+		assert.ErrorContains(t, errList[0], `employee "emp_1"`)
+	}
+
+	// the source department is kept so the merge can be retried
+	assert.False(t, deleted)
+}
+
+func TestDepartmentsMergeSameDepartment(t *testing.T) {
+	client, _ := testClient()
+
+	_, err := client.Departments().Merge(context.Background(), "dep_1", "dep_1")
+
+	var validationErr *gomts.ValidationError
+	assert.ErrorAs(t, err, &validationErr)
+}
+
+func TestDepartmentsListEmployees(t *testing.T) {
+	client := fakeServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v1.2/employees", r.URL.Path)
+
+		fmt.Fprint(w, `{"employees": [
+			{"employee_id": "emp_1", "primary_department_id": "dep_1"},
+			{"employee_id": "emp_2", "primary_department_id": "dep_2", "secondary_department_ids": ["dep_1"]},
+			{"employee_id": "emp_3", "primary_department_id": "dep_1"}
+		]}`)
+	})
+
+	employees, err := client.Departments().ListEmployees(context.Background(), "dep_1")
+	assert.NoError(t, err)
+
+	// only employees whose primary department matches
+	var ids []string
+	for _, employee := range employees {
+		ids = append(ids, employee.ID)
+	}
+
+	assert.Equal(t, []string{"emp_1", "emp_3"}, ids)
+}
+
+func TestDepartmentsListEmployeesByStatus(t *testing.T) {
+	client := fakeServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"employees": [
+			{"employee_id": "emp_1", "status": "in", "primary_department_id": "dep_1"},
+			{"employee_id": "emp_2", "status": "out", "primary_department_id": "dep_1"},
+			{"employee_id": "emp_3", "status": "in", "primary_department_id": "dep_2"}
+		]}`)
+	})
+
+	ctx := context.Background()
+
+	employees, err := client.Departments().ListEmployeesByStatus(ctx, "dep_1", gomts.EmployeeInStatus)
+	assert.NoError(t, err)
+
+	if assert.Len(t, employees, 1) {
+		assert.Equal(t, "emp_1", employees[0].ID)
+	}
+
+	_, err = client.Departments().ListEmployeesByStatus(ctx, "dep_1", "sleeping")
+
+	var validationErr *gomts.ValidationError
+	assert.ErrorAs(t, err, &validationErr)
+}
+
+func TestBuildDepartmentTree(t *testing.T) {
+	tree, err := gomts.BuildDepartmentTree([]gomts.Department{
+		{ID: "dep_1"},
+		{ID: "dep_2", ParentID: "dep_1"},
+		{ID: "dep_3", ParentID: "dep_2"},
+		{ID: "dep_4", ParentID: "dep_missing"},
+	})
+	assert.NoError(t, err)
+
+	if assert.Len(t, tree.Root.Children, 2) {
+		assert.Equal(t, "dep_1", tree.Root.Children[0].ID)
+		assert.Equal(t, "dep_4", tree.Root.Children[1].ID)
+
+		dep2 := tree.Root.Children[0].Children[0]
+		assert.Equal(t, "dep_2", dep2.ID)
+		assert.Equal(t, "dep_3", dep2.Children[0].ID)
+	}
+
+	_, err = gomts.BuildDepartmentTree([]gomts.Department{
+		{ID: "dep_1"},
+		{ID: "dep_2", ParentID: "dep_3"},
+		{ID: "dep_3", ParentID: "dep_2"},
+	})
+	assert.ErrorIs(t, err, gomts.ErrDepartmentCycle)
+
+	_, err = gomts.BuildDepartmentTree([]gomts.Department{{ID: "dep_1", ParentID: "dep_1"}})
+	assert.ErrorIs(t, err, gomts.ErrDepartmentCycle)
+}
+
+// departmentIDAndName returns d with only the fields populated by List, so
+// departments from List can be compared with those from Create or Get.
+func departmentIDAndName(d gomts.Department) gomts.Department {
+	return gomts.Department{ID: d.ID, Name: d.Name}
+}
+
+// departmentIDsAndNames applies departmentIDAndName to each department.
+func departmentIDsAndNames(departments []gomts.Department) []gomts.Department {
+	out := make([]gomts.Department, len(departments))
+
+	for i, d := range departments {
+		out[i] = departmentIDAndName(d)
+	}
+
+	return out
+}
+
+func TestDepartmentsCount(t *testing.T) {
+	client := fakeServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v1.2/departments", r.URL.Path)
+
+		fmt.Fprint(w, `{"departments": [
+			{"department_id": "dep_1"},
+			{"department_id": "dep_2"}
+		]}`)
+	})
+
+	count, err := client.Departments().Count(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, 2, count)
+}
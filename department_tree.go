@@ -0,0 +1,75 @@
+package gomts
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrDepartmentCycle is returned when departments form a cycle of parents.
+var ErrDepartmentCycle = errors.New("department cycle")
+
+// DepartmentTree is a hierarchy of departments built from their parents.
+type DepartmentTree struct {
+	// Root is a synthetic node with a zero Department whose children are the
+	// top-level and orphaned departments.
+	Root *DepartmentNode
+}
+
+// DepartmentNode is a single department within a DepartmentTree.
+type DepartmentNode struct {
+	Department
+
+	// Children are the departments whose parent is this department.
+	Children []*DepartmentNode
+}
+
+// BuildDepartmentTree builds a DepartmentTree from a flat list of departments,
+// such as from DepartmentClient.List. Departments without a parent or whose
+// parent is not in the list are attached to the root. Children keep the order
+// of the list.
+//
+// Returns an error wrapping ErrDepartmentCycle if any departments form a cycle.
+func BuildDepartmentTree(departments []Department) (*DepartmentTree, error) {
+	nodes := make(map[string]*DepartmentNode, len(departments))
+
+	for _, department := range departments {
+		if _, ok := nodes[department.ID]; ok {
+			return nil, fmt.Errorf("duplicate department %q", department.ID)
+		}
+
+		nodes[department.ID] = &DepartmentNode{Department: department}
+	}
+
+	root := new(DepartmentNode)
+
+	for _, department := range departments {
+		node := nodes[department.ID]
+
+		parent, ok := nodes[department.ParentID]
+		if department.ParentID == "" || !ok {
+			parent = root
+		}
+
+		parent.Children = append(parent.Children, node)
+	}
+
+	// departments in or below a cycle are unreachable from the root
+	reached := make(map[string]bool, len(departments))
+	markDepartmentNodes(root, reached)
+
+	for _, department := range departments {
+		if !reached[department.ID] {
+			return nil, fmt.Errorf("%w: in the ancestors of department %q", ErrDepartmentCycle, department.ID)
+		}
+	}
+
+	return &DepartmentTree{Root: root}, nil
+}
+
+// markDepartmentNodes marks the descendants of node as reached.
+func markDepartmentNodes(node *DepartmentNode, reached map[string]bool) {
+	for _, child := range node.Children {
+		reached[child.ID] = true
+		markDepartmentNodes(child, reached)
+	}
+}
@@ -1,8 +1,28 @@
 package gomts
 
-import "context"
+import (
+	"context"
+	"crypto/rand"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"math/big"
+	"net/http"
+	"net/url"
+	"regexp"
+	"slices"
+	"sort"
+	"strconv"
+	"time"
+)
 
 // EmployeeClient interfaces with Employee related MyTimeStation API methods.
+//
+// Methods deriving worked time or punches from clock events, e.g.
+// WorkMinutesToday, read them from Config.ClockEventSource and return
+// ErrNotImplemented if it is not set.
 type EmployeeClient interface {
 	// Create a new employee.
 	Create(ctx context.Context, req *EmployeeCreateRequest) (*Employee, error)
@@ -10,18 +30,156 @@ type EmployeeClient interface {
 	// Get an employee by id.
 	Get(ctx context.Context, id string) (*Employee, error)
 
+	// GetByAnyID gets an employee by any of their identifiers, trying in order
+	// the MyTimeStation employee ID, custom employee ID, PIN and card number.
+	// Returns the first match or a 404 *Error if none match.
+	GetByAnyID(ctx context.Context, id string) (*Employee, error)
+
+	// GetWithRelated gets an employee along with the requested related
+	// entities.
+	GetWithRelated(ctx context.Context, id string, includes ...EmployeeInclude) (*EmployeeWithRelated, error)
+
+	// GetAndLock gets an employee along with its ETag for optimistic
+	// concurrency control. Set the ETag as IfMatch on an update to prevent
+	// clobbering concurrent changes.
+	GetAndLock(ctx context.Context, id string) (*Employee, string, error)
+
 	// List all employees.
 	List(ctx context.Context) ([]Employee, error)
 
+	// ListPage lists a single page of employees, for UIs showing page numbers.
+	// Pages start at 1.
+	ListPage(ctx context.Context, page, perPage int) (*EmployeeListPage, error)
+
+	// ListWithDepartmentDetails lists all employees along with their primary
+	// and current departments.
+	ListWithDepartmentDetails(ctx context.Context) ([]EmployeeWithDepartment, error)
+
+	// ListWithTimeToday lists all employees along with the time they have
+	// worked today.
+	ListWithTimeToday(ctx context.Context) ([]EmployeeWithTimeToday, error)
+
+	// ListWithHoursThisWeek lists all employees along with the time they have
+	// worked this week.
+	ListWithHoursThisWeek(ctx context.Context) ([]EmployeeWithHours, error)
+
+	// WorkloadComparison compares the time worked within r by the employees
+	// whose primary department is the given department, sorted most worked
+	// first.
+	WorkloadComparison(ctx context.Context, departmentID string, r DateRange) ([]EmployeeWorkload, error)
+
+	// GetMostRecentPunch gets the most recent clock event of an employee by
+	// id. Returns a 404 *Error if the employee has never punched.
+	GetMostRecentPunch(ctx context.Context, employeeID string) (*ClockEvent, error)
+
+	// ListNotWorkedSince lists all employees who have not punched since the
+	// given time, including those who have never punched, sorted longest
+	// inactive first.
+	ListNotWorkedSince(ctx context.Context, since time.Time) ([]Employee, error)
+
+	// ListByStatus lists all employees with the given clock status.
+	ListByStatus(ctx context.Context, status EmployeeStatus) ([]Employee, error)
+
+	// ListActive lists all employees who are clocked in, including those on a
+	// break.
+	ListActive(ctx context.Context) ([]Employee, error)
+
+	// ListInactive lists all employees who are clocked out.
+	ListInactive(ctx context.Context) ([]Employee, error)
+
+	// ListByDepartmentStatus lists all employees who are clocked in, including
+	// those on a break, keyed by the ID of the department they are currently
+	// working in.
+	ListByDepartmentStatus(ctx context.Context) (map[string][]Employee, error)
+
+	// ListByHourlyRateRange lists all employees whose hourly rate is between
+	// minRate and maxRate, inclusive.
+	ListByHourlyRateRange(ctx context.Context, minRate, maxRate float64) ([]Employee, error)
+
+	// ListByDepartment lists all employees whose primary department is the
+	// given department.
+	ListByDepartment(ctx context.Context, departmentID string) ([]Employee, error)
+
 	// Update an employee by id.
 	Update(ctx context.Context, id string, req *EmployeeUpdateRequest) (*Employee, error)
 
 	// Delete an employee by id.
-	Delete(ctx context.Context, id string) (*Employee, error)
+	Delete(ctx context.Context, id string) (*DeleteResult, error)
+
+	// Count the number of employees.
+	Count(ctx context.Context) (int, error)
+
+	// SetCustomFields replaces all custom fields of an employee by id with
+	// fields, removing any not in fields.
+	SetCustomFields(ctx context.Context, employeeID string, fields map[string]string) (*Employee, error)
+
+	// PatchCustomFields merges fields into the custom fields of an employee by
+	// id, preserving any not in fields.
+	PatchCustomFields(ctx context.Context, employeeID string, fields map[string]string) (*Employee, error)
+
+	// SetPIN sets the PIN of an employee by id. The PIN must be exactly 4
+	// digits.
+	SetPIN(ctx context.Context, employeeID, pin string) (*Employee, error)
+
+	// SetEmail sets the "email" custom field of an employee by id, preserving
+	// other custom fields. The email must be a valid address.
+	SetEmail(ctx context.Context, employeeID, email string) (*Employee, error)
+
+	// ListSecondaryDepartments lists the secondary departments of an employee
+	// by id.
+	ListSecondaryDepartments(ctx context.Context, employeeID string) ([]Department, error)
+
+	// ImportFromJSON creates an employee for each EmployeeCreateRequest in a
+	// JSON array read from r. Each employee is created independently; the
+	// number created and the errors of those which failed are returned.
+	ImportFromJSON(ctx context.Context, r io.Reader) (imported int, errs []error)
+
+	// BatchAssignDepartment sets the primary department of many employees
+	// concurrently, returning a result for each employee in order.
+	BatchAssignDepartment(ctx context.Context, employeeIDs []string, departmentID string, keepOldAsSecondary bool) ([]BatchResult, error)
+
+	// SetDepartment sets the primary department of an employee by id,
+	// optionally keeping the previous primary department as a secondary one.
+	SetDepartment(ctx context.Context, employeeID, departmentID string, keepOldAsSecondary bool) (*Employee, error)
+
+	// AssignOvertimePolicy sets the overtime policy of an employee by id.
+	AssignOvertimePolicy(ctx context.Context, employeeID, policyID string) (*Employee, error)
+
+	// WorkMinutesToday gets the minutes an employee by id has worked today,
+	// excluding breaks and including their current session.
+	WorkMinutesToday(ctx context.Context, employeeID string) (int, error)
+
+	// GetWorkdayMinutes gets the minutes an employee by id worked on the day
+	// of date, excluding breaks.
+	GetWorkdayMinutes(ctx context.Context, employeeID string, date time.Time) (int, error)
+
+	// GetWorkdayBreakMinutes gets the minutes an employee by id spent on a
+	// break on the day of date.
+	GetWorkdayBreakMinutes(ctx context.Context, employeeID string, date time.Time) (int, error)
+
+	// IsNearHoursCap reports whether an employee by id has worked at least
+	// threshold percent (e.g., 90) of their MaxWeeklyMinutes this week. Always
+	// false for employees without a cap.
+	IsNearHoursCap(ctx context.Context, employeeID string, threshold float64) (bool, error)
+
+	// IsFullTime reports whether an employee by id averaged at least 30 hours
+	// a week, excluding breaks, over the given number of past weeks.
+	IsFullTime(ctx context.Context, employeeID string, weeks int) (bool, error)
+
+	// IsPINUnique reports whether no employee is assigned the given PIN.
+	IsPINUnique(ctx context.Context, pin string) (bool, error)
+
+	// ValidateSchedule finds the existing shifts of an employee by id which
+	// conflict with newShift.
+	//
+	// Not yet implemented: the MyTimeStation API does not expose schedules,
+	// so there are no existing shifts to fetch. Always returns
+	// ErrNotImplemented; use DetectConflicts with shifts from another source.
+	ValidateSchedule(ctx context.Context, employeeID string, newShift Shift) ([]ShiftConflict, error)
 }
 
 // EmployeeStatus represents the employee's clock-in/out state.
-// Valid values *should* only be "in" or "out".
+// Valid values *should* only be "in", "out" or "break".
 type EmployeeStatus string
 
 const (
@@ -30,8 +188,85 @@ const (
 
 	// EmployeeOutStatus signals the employee is clocked out.
 	EmployeeOutStatus EmployeeStatus = "out"
+
+	// EmployeeOnBreakStatus signals the employee is clocked in but on a break.
+	EmployeeOnBreakStatus EmployeeStatus = "break"
+)
+
+// ParseEmployeeStatus parses s into an EmployeeStatus, returning a
+// *ValidationError if it is not a valid status.
+func ParseEmployeeStatus(s string) (EmployeeStatus, error) {
+	status := EmployeeStatus(s)
+
+	if err := status.Validate(); err != nil {
+		return "", err
+	}
+
+	return status, nil
+}
+
+// IsValid reports whether s is a known employee status.
+func (s EmployeeStatus) IsValid() bool {
+	switch s {
+	case EmployeeInStatus, EmployeeOutStatus, EmployeeOnBreakStatus:
+		return true
+	default:
+		return false
+	}
+}
+
+// IsClockedIn reports whether s is EmployeeInStatus or EmployeeOnBreakStatus.
+func (s EmployeeStatus) IsClockedIn() bool {
+	return s == EmployeeInStatus || s == EmployeeOnBreakStatus
+}
+
+// Validate returns a *ValidationError if s is not a known employee status.
+func (s EmployeeStatus) Validate() error {
+	if !s.IsValid() {
+		return &ValidationError{
+			Field:  "status",
+			Reason: fmt.Sprintf("%q is not one of %q, %q or %q", s, EmployeeInStatus, EmployeeOutStatus, EmployeeOnBreakStatus),
+		}
+	}
+
+	return nil
+}
+
+// EmployeeRole represents the permission level of an employee.
+//
+// The MyTimeStation API has no native role field, so roles are emulated with
+// the "role" custom field, which must be defined on the account. Set it with
+// CustomFields on EmployeeCreateRequest or EmployeeUpdateRequest and read it
+// with RoleFromCustomFields.
+type EmployeeRole string
+
+const (
+	// RoleEmployee is a regular employee. This is the default role.
+	RoleEmployee EmployeeRole = "employee"
+
+	// RoleTeamLead is an employee leading a team.
+	RoleTeamLead EmployeeRole = "team_lead"
+
+	// RoleManager is an employee managing a department.
+	RoleManager EmployeeRole = "manager"
+
+	// RoleAdmin is an account administrator.
+	RoleAdmin EmployeeRole = "admin"
 )
 
+// roleCustomField is the custom field key used to emulate employee roles.
+const roleCustomField = "role"
+
+// RoleFromCustomFields gets the role of the employee from the "role" custom
+// field. Returns RoleEmployee if it is not set.
+func RoleFromCustomFields(e *Employee) EmployeeRole {
+	if role := e.CustomFields[roleCustomField]; role != "" {
+		return EmployeeRole(role)
+	}
+
+	return RoleEmployee
+}
+
 // Employee represents an employee working for a customer company in the
 // MyTimeStation system.
 type Employee struct {
@@ -51,6 +286,14 @@ type Employee struct {
 	// PrimaryDepartmentID is the unique identifier for the primary department.
 	PrimaryDepartmentID string `json:"primary_department_id"`
 
+	// SecondaryDepartments are the additional departments where the employee
+	// works.
+	SecondaryDepartments []Department `json:"secondary_departments"`
+
+	// SecondaryDepartmentIDs are the unique identifiers for the secondary
+	// departments.
+	SecondaryDepartmentIDs []string `json:"secondary_department_ids"`
+
 	// CurrentDepartment is the department where the employee is currently
 	// working (can be different from primary).
 	CurrentDepartment string `json:"current_department"`
@@ -58,9 +301,28 @@ type Employee struct {
 	// CurrentDepartmentID is the unique identifier for the current department.
 	CurrentDepartmentID string `json:"current_department_id"`
 
-	// Status represents the employee's current clock-in status (in or out).
+	// Status represents the employee's current clock-in status (in, out or
+	// break).
 	Status EmployeeStatus `json:"status"`
 
+	// HourlyRate is the hourly wage rate of the employee. Some accounts store
+	// it in CustomFields instead; see GetHourlyRate.
+	HourlyRate float64 `json:"hourly_rate"`
+
+	// OvertimePolicyID is the unique identifier of the overtime policy
+	// overriding the company default for the employee, if any.
+	OvertimePolicyID string `json:"overtime_policy_id"`
+
+	// MaxWeeklyMinutes is the contractual cap on minutes the employee may work
+	// per week, if any.
+	MaxWeeklyMinutes *int `json:"max_weekly_minutes,omitempty"`
+
+	// StartDate is the first day of employment of the employee, if recorded.
+	StartDate *Date `json:"start_date,omitempty"`
+
+	// CreatedAt is when the employee was created.
+	CreatedAt time.Time `json:"created_at"`
+
 	// CustomEmployeeID is the company-defined employee ID, which may differ
 	// from the system-generated ID.
 	CustomEmployeeID string `json:"custom_employee_id"`
@@ -81,12 +343,290 @@ type Employee struct {
 	CustomFields map[string]string `json:"custom_fields"`
 }
 
+// IsOnBreak reports whether the employee is on a break.
+func (e *Employee) IsOnBreak() bool {
+	return e.Status == EmployeeOnBreakStatus
+}
+
+// IsWorking reports whether the employee is clocked in and not on a break.
+func (e *Employee) IsWorking() bool {
+	return e.Status == EmployeeInStatus
+}
+
+// isManagerCustomField is the conventional custom field key used to flag
+// managers independently of their role.
+const isManagerCustomField = "is_manager"
+
+// IsManager reports whether the employee is a manager, either by having the
+// RoleManager role or the "is_manager" custom field set to "true".
+func (e *Employee) IsManager() bool {
+	return RoleFromCustomFields(e) == RoleManager || e.CustomFields[isManagerCustomField] == "true"
+}
+
+// InDepartment reports whether the department with the given ID is the
+// primary or a secondary department of the employee.
+func (e *Employee) InDepartment(id string) bool {
+	if e.PrimaryDepartmentID == id || slices.Contains(e.SecondaryDepartmentIDs, id) {
+		return true
+	}
+
+	return slices.ContainsFunc(e.SecondaryDepartments, func(d Department) bool {
+		return d.ID == id
+	})
+}
+
+// InDepartmentNamed reports whether the department with the given name is the
+// primary or a secondary department of the employee.
+func (e *Employee) InDepartmentNamed(name string) bool {
+	if e.PrimaryDepartment == name {
+		return true
+	}
+
+	return slices.ContainsFunc(e.SecondaryDepartments, func(d Department) bool {
+		return d.Name == name
+	})
+}
+
+// PrimaryDept returns the primary department of the employee built from
+// PrimaryDepartmentID and PrimaryDepartment. Only ID and Name are set; use
+// DepartmentClient.Get for the full department.
+func (e *Employee) PrimaryDept() *Department {
+	return &Department{ID: e.PrimaryDepartmentID, Name: e.PrimaryDepartment}
+}
+
+// ErrMissingCustomField is returned when a required custom field is not set on
+// an employee.
+var ErrMissingCustomField = errors.New("missing custom field")
+
+// HasCustomField reports whether the custom field is set on the employee, even
+// if its value is empty.
+func (e *Employee) HasCustomField(key string) bool {
+	_, ok := e.CustomFields[key]
+	return ok
+}
+
+// MustCustomField gets the value of a custom field, returning an error
+// wrapping ErrMissingCustomField if it is not set.
+func (e *Employee) MustCustomField(key string) (string, error) {
+	value, ok := e.CustomFields[key]
+	if !ok {
+		return "", fmt.Errorf("%w: %q", ErrMissingCustomField, key)
+	}
+
+	return value, nil
+}
+
+// CustomFieldOr gets the value of a custom field or defaultVal if it is not
+// set.
+func (e *Employee) CustomFieldOr(key, defaultVal string) string {
+	if value, ok := e.CustomFields[key]; ok {
+		return value
+	}
+
+	return defaultVal
+}
+
+// emailCustomField is the custom field key used to store the email of an
+// employee; see EmployeeClient.SetEmail.
+const emailCustomField = "email"
+
+// GetEmail gets the email of the employee from the "email" custom field. ok is
+// false if the field is not set.
+func (e *Employee) GetEmail() (email string, ok bool) {
+	email, ok = e.CustomFields[emailCustomField]
+	return email, ok
+}
+
+// hourlyRateCustomField is the custom field key some accounts use to store the
+// hourly rate.
+const hourlyRateCustomField = "hourly_rate"
+
+// GetHourlyRate gets the hourly rate of the employee from HourlyRate, falling
+// back to parsing the "hourly_rate" custom field. Returns 0 if neither is set.
+func (e *Employee) GetHourlyRate() (float64, error) {
+	if e.HourlyRate != 0 {
+		return e.HourlyRate, nil
+	}
+
+	raw, ok := e.CustomFields[hourlyRateCustomField]
+	if !ok || raw == "" {
+		return 0, nil
+	}
+
+	rate, err := strconv.ParseFloat(raw, 64)
+	if err != nil {
+		return 0, fmt.Errorf("could not parse %q custom field: %w", hourlyRateCustomField, err)
+	}
+
+	return rate, nil
+}
+
+// SetHourlyRate builds an update request which only sets the hourly rate of
+// the employee, written to wherever GetHourlyRate reads it from.
+func (e *Employee) SetHourlyRate(rate float64) *EmployeeUpdateRequest {
+	if _, ok := e.CustomFields[hourlyRateCustomField]; ok && e.HourlyRate == 0 {
+		return &EmployeeUpdateRequest{
+			CustomFields: map[string]string{
+				hourlyRateCustomField: strconv.FormatFloat(rate, 'f', -1, 64),
+			},
+		}
+	}
+
+	return &EmployeeUpdateRequest{HourlyRate: &rate}
+}
+
+// EmployeeWithDepartment is an employee joined with its departments.
+type EmployeeWithDepartment struct {
+	Employee
+
+	// PrimaryDepartment is the main department where the employee works.
+	PrimaryDepartment Department
+
+	// CurrentDepartment is the department where the employee is currently
+	// working.
+	CurrentDepartment Department
+}
+
+// EmployeeWithTimeToday is an employee joined with the time they have worked
+// today.
+type EmployeeWithTimeToday struct {
+	Employee
+
+	// MinutesWorkedToday is the minutes the employee has worked today,
+	// excluding breaks.
+	MinutesWorkedToday int
+
+	// CurrentSessionMinutes is the minutes since the employee clocked in, if
+	// they are currently clocked in.
+	CurrentSessionMinutes int
+}
+
+// BatchResult is the result of a batch operation for a single employee.
+type BatchResult struct {
+	// EmployeeID is the ID of the employee operated on.
+	EmployeeID string
+
+	// Success signals the operation succeeded.
+	Success bool
+
+	// Err is the error the operation failed with, if any.
+	Err error
+}
+
+// EmployeeInclude represents an entity related to an employee which can be
+// fetched along with them by EmployeeClient.GetWithRelated.
+type EmployeeInclude string
+
+const (
+	// IncludeTimeToday includes the minutes the employee has worked today.
+	IncludeTimeToday EmployeeInclude = "time_today"
+
+	// IncludeDepartment includes the primary department of the employee.
+	IncludeDepartment EmployeeInclude = "department"
+
+	// IncludeShift includes the shift the employee is currently scheduled
+	// for. Not yet implemented as the MyTimeStation API does not expose
+	// schedules; requesting it returns ErrNotImplemented.
+	IncludeShift EmployeeInclude = "shift"
+)
+
+// EmployeeWithRelated is an employee joined with related entities. Fields are
+// nil if their include was not requested.
+type EmployeeWithRelated struct {
+	Employee
+
+	// CurrentShift is the shift the employee is currently scheduled for.
+	// Always nil until IncludeShift is implemented.
+	CurrentShift *Shift
+
+	// MinutesToday is the minutes the employee has worked today, excluding
+	// breaks.
+	MinutesToday *int
+
+	// Department is the primary department of the employee. Nil if the
+	// employee has no primary department.
+	Department *Department
+}
+
+// EmployeeWithHours is an employee joined with the time they have worked this
+// week.
+type EmployeeWithHours struct {
+	Employee
+
+	// MinutesThisWeek is the minutes the employee has worked this week,
+	// excluding breaks.
+	MinutesThisWeek int
+}
+
+// EmployeeWorkload is an employee joined with the time they worked within a
+// date range, relative to the other employees compared.
+type EmployeeWorkload struct {
+	Employee
+
+	// TotalMinutes is the minutes the employee worked, excluding breaks.
+	TotalMinutes int
+
+	// PercentOfMax is TotalMinutes as a percentage of the most minutes worked
+	// by any employee compared. Zero if no employee worked.
+	PercentOfMax float64
+}
+
 // EmployeeListResponse is the response used for the List API method.
 type EmployeeListResponse struct {
+	// Meta is the pagination metadata.
+	Meta `json:"meta"`
+
 	// Employees is the list of employees.
 	Employees []Employee `json:"employees"`
 }
 
+// EmployeeListRequest represents the query parameters used for the List API
+// method.
+type EmployeeListRequest struct {
+	// Page is the page number, starting at 1.
+	Page int `url:"page,omitempty"`
+
+	// PerPage is the maximum number of employees per page.
+	PerPage int `url:"per_page,omitempty"`
+}
+
+// NewEmployeeListRequest returns an EmployeeListRequest for the first page of
+// 50 employees.
+func NewEmployeeListRequest() *EmployeeListRequest {
+	return &EmployeeListRequest{Page: 1, PerPage: 50}
+}
+
+// Validate returns a *ValidationError if the request is invalid.
+func (r *EmployeeListRequest) Validate() error {
+	if r.Page < 1 {
+		return &ValidationError{Field: "page", Reason: "must be at least 1"}
+	}
+
+	if r.PerPage < 1 {
+		return &ValidationError{Field: "per_page", Reason: "must be at least 1"}
+	}
+
+	return nil
+}
+
+// EmployeeListPage is a single page of employees.
+type EmployeeListPage struct {
+	// Employees are the employees on the page.
+	Employees []Employee
+
+	// Page is the page number, starting at 1.
+	Page int
+
+	// PerPage is the maximum number of employees per page.
+	PerPage int
+
+	// Total is the total number of employees across all pages.
+	Total int
+
+	// TotalPages is the total number of pages.
+	TotalPages int
+}
+
 // EmployeeResponse is the response used for the Create, Get, Update and Delete
 // API methods.
 type EmployeeResponse struct {
@@ -99,33 +639,47 @@ type EmployeeResponse struct {
 type EmployeeCreateRequest struct {
 	// Name is the full name of the employee.
 	// This field is required.
-	Name string `url:"name"`
+	Name string `url:"name" json:"name"`
 
 	// DepartmentID is the ID of the primary department to assign the employee.
 	// Either DepartmentID or DepartmentName must be supplied.
-	DepartmentID string `url:"department_id,omitempty"`
+	DepartmentID string `url:"department_id,omitempty" json:"department_id,omitempty"`
 
 	// DepartmentName is the name of the department to assign the employee.
 	// It can either create a new department or use an existing one.
 	// Either DepartmentID or DepartmentName must be supplied.
-	DepartmentName string `url:"department_name,omitempty"`
+	DepartmentName string `url:"department_name,omitempty" json:"department_name,omitempty"`
+
+	// SecondaryDepartmentIDs are the IDs of additional departments to assign
+	// the employee.
+	SecondaryDepartmentIDs []string `url:"secondary_department_ids,brackets,omitempty" json:"secondary_department_ids,omitempty"`
 
 	// CustomEmployeeID is an optional second ID to associate the employee with
 	// another system.
-	CustomEmployeeID string `url:"custom_employee_id,omitempty"`
+	CustomEmployeeID string `url:"custom_employee_id,omitempty" json:"custom_employee_id,omitempty"`
 
 	// Title is the job title of the employee (e.g., Payroll Manager).
-	Title string `url:"title,omitempty"`
+	Title string `url:"title,omitempty" json:"title,omitempty"`
 
 	// HourlyRate is the hourly wage rate of the employee.
-	HourlyRate float64 `url:"hourly_rate,omitempty"`
+	HourlyRate float64 `url:"hourly_rate,omitempty" json:"hourly_rate,omitempty"`
 
 	// PIN is the 4-digit personal identification number for the employee.
-	PIN string `url:"pin,omitempty"`
+	PIN string `url:"pin,omitempty" json:"pin,omitempty"`
+
+	// MaxWeeklyMinutes is the cap on minutes the employee may work per week.
+	MaxWeeklyMinutes *int `url:"max_weekly_minutes,omitempty" json:"max_weekly_minutes,omitempty"`
+
+	// StartDate is the first day of employment of the employee.
+	StartDate *Date `url:"start_date,omitempty" json:"start_date,omitempty"`
+
+	// GeneratePIN generates a PIN client-side with Config.PINGenerator if PIN
+	// is empty. The generated PIN is set on the request before it is sent.
+	GeneratePIN bool `url:"-" json:"generate_pin,omitempty"`
 
 	// CustomFields allows setting one or more custom fields for the employee.
 	// The key is the custom field name, and the value is the field value.
-	CustomFields map[string]string `url:"custom_fields,omitempty"`
+	CustomFields FormCustomFields `url:"custom_fields,omitempty" json:"custom_fields,omitempty"`
 }
 
 func (EmployeeCreateRequest) form() {}
@@ -134,7 +688,7 @@ func (EmployeeCreateRequest) form() {}
 // employee in the MyTimeStation system.
 type EmployeeUpdateRequest struct {
 	// Name is the full name of the employee.
-	Name *string `json:"name"`
+	Name *string `json:"name,omitempty"`
 
 	// DepartmentID is the ID of the primary department to assign the employee.
 	// Either DepartmentID or DepartmentName must be supplied.
@@ -158,25 +712,187 @@ type EmployeeUpdateRequest struct {
 	// PIN is the 4-digit personal identification number for the employee.
 	PIN *string `json:"pin,omitempty"`
 
+	// OvertimePolicyID is the ID of the overtime policy overriding the company
+	// default for the employee. Set to empty to revert to the default.
+	OvertimePolicyID *string `json:"overtime_policy_id,omitempty"`
+
+	// MaxWeeklyMinutes is the cap on minutes the employee may work per week.
+	// Set to zero to remove the cap.
+	MaxWeeklyMinutes *int `json:"max_weekly_minutes,omitempty"`
+
+	// StartDate is the first day of employment of the employee. Set to the
+	// zero Date to remove it.
+	StartDate *Date `json:"start_date,omitempty"`
+
 	// CustomFields allows setting one or more custom fields for the employee.
 	// The key is the custom field name, and the value is the field value.
 	CustomFields map[string]string `json:"custom_fields,omitempty"`
 
+	// DeleteCustomFields lists the keys of custom fields to remove from the
+	// employee. Deletion takes precedence over a key also set in CustomFields.
+	DeleteCustomFields []string `json:"-"`
+
 	// ConvertPrimaryDepartment indicates if the previous primary department
 	// should be retained as a secondary department when the primary department
 	// is changed. This parameter applies only to the current API request.
 	ConvertPrimaryDepartment *bool `json:"convert_primary_department,omitempty"`
+
+	// IfMatch makes the update conditional on the employee being unchanged
+	// since the ETag was returned by GetAndLock. If the employee has changed,
+	// the update fails with an error matching ErrConflict.
+	IfMatch string `json:"-"`
+}
+
+// MarshalJSON implements json.Marshaler. Keys in DeleteCustomFields are encoded
+// as null values in custom_fields, which removes them from the employee.
+func (r EmployeeUpdateRequest) MarshalJSON() ([]byte, error) {
+	// alias drops the MarshalJSON method to avoid infinite recursion
+	type alias EmployeeUpdateRequest
+
+	if len(r.DeleteCustomFields) == 0 {
+		return json.Marshal(alias(r))
+	}
+
+	customFields := make(map[string]*string, len(r.CustomFields)+len(r.DeleteCustomFields))
+
+	for key, value := range r.CustomFields {
+		customFields[key] = &value
+	}
+
+	for _, key := range r.DeleteCustomFields {
+		customFields[key] = nil
+	}
+
+	return json.Marshal(struct {
+		alias
+		CustomFields map[string]*string `json:"custom_fields,omitempty"`
+	}{
+		alias:        alias(r),
+		CustomFields: customFields,
+	})
+}
+
+// Clear sets every optional field to a pointer to its zero value so the
+// update resets them on the employee. Name and departments are required and
+// left unchanged. The MyTimeStation API cannot empty custom fields wholesale,
+// so the keys of the custom fields to remove must be given; they are set as
+// DeleteCustomFields. Returns the receiver for chaining.
+func (r *EmployeeUpdateRequest) Clear(customFieldKeys ...string) *EmployeeUpdateRequest {
+	r.CustomEmployeeID = new(string)
+	r.Title = new(string)
+	r.HourlyRate = new(float64)
+	r.PIN = new(string)
+	r.OvertimePolicyID = new(string)
+	r.MaxWeeklyMinutes = new(int)
+	r.StartDate = new(Date)
+	r.CustomFields = nil
+	r.DeleteCustomFields = customFieldKeys
+
+	return r
+}
+
+// WithName sets Name and returns the request for chaining.
+func (r *EmployeeUpdateRequest) WithName(name string) *EmployeeUpdateRequest {
+	r.Name = &name
+	return r
+}
+
+// WithDepartmentID sets DepartmentID and returns the request for chaining.
+func (r *EmployeeUpdateRequest) WithDepartmentID(id string) *EmployeeUpdateRequest {
+	r.DepartmentID = &id
+	return r
+}
+
+// WithDepartmentName sets DepartmentName and returns the request for chaining.
+func (r *EmployeeUpdateRequest) WithDepartmentName(name string) *EmployeeUpdateRequest {
+	r.DepartmentName = &name
+	return r
+}
+
+// WithCustomEmployeeID sets CustomEmployeeID and returns the request for chaining.
+func (r *EmployeeUpdateRequest) WithCustomEmployeeID(id string) *EmployeeUpdateRequest {
+	r.CustomEmployeeID = &id
+	return r
+}
+
+// WithTitle sets Title and returns the request for chaining.
+func (r *EmployeeUpdateRequest) WithTitle(title string) *EmployeeUpdateRequest {
+	r.Title = &title
+	return r
+}
+
+// WithHourlyRate sets HourlyRate and returns the request for chaining.
+func (r *EmployeeUpdateRequest) WithHourlyRate(rate float64) *EmployeeUpdateRequest {
+	r.HourlyRate = &rate
+	return r
+}
+
+// WithPIN sets PIN and returns the request for chaining.
+func (r *EmployeeUpdateRequest) WithPIN(pin string) *EmployeeUpdateRequest {
+	r.PIN = &pin
+	return r
+}
+
+// WithOvertimePolicyID sets OvertimePolicyID and returns the request for chaining.
+func (r *EmployeeUpdateRequest) WithOvertimePolicyID(id string) *EmployeeUpdateRequest {
+	r.OvertimePolicyID = &id
+	return r
+}
+
+// WithMaxWeeklyMinutes sets MaxWeeklyMinutes and returns the request for chaining.
+func (r *EmployeeUpdateRequest) WithMaxWeeklyMinutes(minutes int) *EmployeeUpdateRequest {
+	r.MaxWeeklyMinutes = &minutes
+	return r
+}
+
+// WithStartDate sets StartDate and returns the request for chaining.
+func (r *EmployeeUpdateRequest) WithStartDate(date Date) *EmployeeUpdateRequest {
+	r.StartDate = &date
+	return r
+}
+
+// WithConvertPrimaryDepartment sets ConvertPrimaryDepartment and returns the request for chaining.
+func (r *EmployeeUpdateRequest) WithConvertPrimaryDepartment(convert bool) *EmployeeUpdateRequest {
+	r.ConvertPrimaryDepartment = &convert
+	return r
 }
 
 // employeeService implements EmployeeClient
 type employeeClient = client
 
 func (c *employeeClient) Create(ctx context.Context, req *EmployeeCreateRequest) (*Employee, error) {
+	generatedPIN := req.GeneratePIN && req.PIN == ""
+
+	if generatedPIN {
+		pin, err := c.conf.GetPINGenerator()()
+		if err != nil {
+			return nil, err
+		}
+
+		req.PIN = pin
+	}
+
+	if c.conf.ValidatePINUniqueness && req.PIN != "" {
+		unique, err := c.IsPINUnique(ctx, req.PIN)
+		if err != nil {
+			return nil, err
+		}
+
+		if !unique {
+			return nil, &ValidationError{Field: "pin", Reason: "already assigned to another employee"}
+		}
+	}
+
 	resp, err := httpPost[EmployeeResponse](ctx, c, "/employees", req)
 	if err != nil {
 		return nil, err
 	}
 
+	// the generated PIN must reach the caller even if it is not echoed back
+	if generatedPIN && resp.Employee.PIN == "" {
+		resp.Employee.PIN = req.PIN
+	}
+
 	return &resp.Employee, nil
 }
 
@@ -189,31 +905,860 @@ func (c *employeeClient) Get(ctx context.Context, id string) (*Employee, error)
 	return &resp.Employee, nil
 }
 
-func (c *employeeClient) Update(ctx context.Context, id string, req *EmployeeUpdateRequest) (*Employee, error) {
-	resp, err := httpPut[EmployeeResponse](ctx, c, "/employees/"+id, req)
+// GetByAnyID makes at most two calls: a get by the escaped employee ID and, if
+// that is not found or rejected as malformed, a single list call from which
+// the other identifiers are looked up, as the MyTimeStation API does not
+// support looking up employees by them.
+func (c *employeeClient) GetByAnyID(ctx context.Context, id string) (*Employee, error) {
+	if id == "" {
+		return nil, &ValidationError{Field: "id", Reason: "is required"}
+	}
+
+	// external identifiers may contain characters such as / or ?
+	employee, err := c.Get(ctx, url.PathEscape(id))
+
+	// a malformed employee ID may be rejected rather than not found
+	var mtsErr *Error
+	if !errors.As(err, &mtsErr) || (mtsErr.ErrorCode != http.StatusNotFound && mtsErr.ErrorCode != http.StatusBadRequest) {
+		return employee, err
+	}
+
+	employees, err := c.List(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	return &resp.Employee, nil
+	idx := NewEmployeeIndex(employees)
+
+	for _, get := range []func(string) (*Employee, bool){
+		idx.GetByCustomID,
+		idx.GetByPIN,
+		idx.GetByCardNumber,
+	} {
+		if employee, ok := get(id); ok {
+			return employee, nil
+		}
+	}
+
+	return nil, &Error{ErrorCode: http.StatusNotFound, ErrorText: "employee not found"}
 }
 
-func (c *employeeClient) Delete(ctx context.Context, id string) (*Employee, error) {
-	resp, err := httpDelete[EmployeeResponse](ctx, c, "/employees/"+id)
-	if err != nil {
-		return nil, err
+// GetWithRelated fetches the includes client-side as the MyTimeStation API
+// does not support expanding related entities. Time today is fetched
+// concurrently with the employee; the department is fetched afterwards.
+func (c *employeeClient) GetWithRelated(ctx context.Context, id string, includes ...EmployeeInclude) (*EmployeeWithRelated, error) {
+	var includeTimeToday, includeDepartment bool
+
+	for _, include := range includes {
+		switch include {
+		case IncludeTimeToday:
+			includeTimeToday = true
+		case IncludeDepartment:
+			includeDepartment = true
+		case IncludeShift:
+			return nil, ErrNotImplemented
+		default:
+			return nil, &ValidationError{Field: "includes", Reason: fmt.Sprintf("unknown include %q", include)}
+		}
 	}
 
-	return &resp.Employee, nil
+	var out EmployeeWithRelated
+
+	if includeTimeToday {
+		now := c.now()
+
+		employee, summary, err := c.summarizeEmployeeTime(ctx, id, dayRange(now), now)
+		if err != nil {
+			return nil, err
+		}
+
+		minutes := int(summary.worked.Minutes())
+
+		out.Employee = *employee
+		out.MinutesToday = &minutes
+	} else {
+		employee, err := c.Get(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+
+		out.Employee = *employee
+	}
+
+	// employees without a primary department are left without one
+	if includeDepartment && out.PrimaryDepartmentID != "" {
+		department, err := c.departments.Get(ctx, out.PrimaryDepartmentID)
+		if err != nil {
+			return nil, err
+		}
+
+		out.Department = department
+	}
+
+	return &out, nil
 }
 
-func (c *employeeClient) List(ctx context.Context) ([]Employee, error) {
-	resp, err := httpGet[EmployeeListResponse](ctx, c, "/employees")
+// GetAndLock returns the ETag of the employee, which is empty if the
+// MyTimeStation API did not return one, in which case updates with IfMatch
+// set are unconditional.
+func (c *employeeClient) GetAndLock(ctx context.Context, id string) (*Employee, string, error) {
+	resp, header, err := httpDoWithHeader[EmployeeResponse](ctx, c, http.MethodGet, "/employees/"+id, nil, nil)
 	if err != nil {
-		return nil, err
+		return nil, "", err
 	}
 
-	return resp.Employees, nil
+	return &resp.Employee, header.Get("ETag"), nil
+}
+
+func (c *employeeClient) Update(ctx context.Context, id string, req *EmployeeUpdateRequest) (*Employee, error) {
+	var header http.Header
+	if req.IfMatch != "" {
+		header = http.Header{"If-Match": {req.IfMatch}}
+	}
+
+	update := func() (*Employee, error) {
+		resp, _, err := httpDoWithHeader[EmployeeResponse](ctx, c, http.MethodPut, "/employees/"+id, req, header)
+		if err != nil {
+			return nil, err
+		}
+
+		return &resp.Employee, nil
+	}
+
+	if c.conf.EmployeeChangeLog != nil {
+		return c.updateWithChangeLog(ctx, id, update)
+	}
+
+	return update()
+}
+
+func (c *employeeClient) Delete(ctx context.Context, id string) (*DeleteResult, error) {
+	resp, err := httpDelete[EmployeeResponse](ctx, c, "/employees/"+id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &DeleteResult{ID: resp.Employee.ID, DeletedAt: time.Now()}, nil
+}
+
+func (c *employeeClient) List(ctx context.Context) ([]Employee, error) {
+	resp, err := httpGet[EmployeeListResponse](ctx, c, "/employees")
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Employees, nil
+}
+
+// ListPage falls back to paginating client-side if the MyTimeStation API
+// returns all employees at once, as signalled by a zero Meta.TotalCount.
+func (c *employeeClient) ListPage(ctx context.Context, page, perPage int) (*EmployeeListPage, error) {
+	req := NewEmployeeListRequest()
+	req.Page = page
+	req.PerPage = perPage
+
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	path, err := withQuery("/employees", req)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := httpGet[EmployeeListResponse](ctx, c, path)
+	if err != nil {
+		return nil, err
+	}
+
+	out := &EmployeeListPage{
+		Employees: resp.Employees,
+		Page:      page,
+		PerPage:   perPage,
+		Total:     resp.TotalCount,
+	}
+
+	if resp.TotalCount == 0 {
+		// not paginated by the server
+		out.Total = len(resp.Employees)
+
+		start := min((page-1)*perPage, len(resp.Employees))
+		end := min(start+perPage, len(resp.Employees))
+
+		out.Employees = resp.Employees[start:end]
+	}
+
+	out.TotalPages = (out.Total + perPage - 1) / perPage
+
+	return out, nil
+}
+
+// ListWithDepartmentDetails is a client-side join of concurrent employee and
+// department list calls. Departments which could not be resolved are left
+// zero-valued.
+func (c *employeeClient) ListWithDepartmentDetails(ctx context.Context) ([]EmployeeWithDepartment, error) {
+	var (
+		employees   []Employee
+		departments []Department
+	)
+
+	err := runConcurrently(
+		func() (err error) {
+			employees, err = c.List(ctx)
+			return err
+		},
+		func() (err error) {
+			departments, err = c.departments.List(ctx)
+			return err
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	departmentsByID := make(map[string]Department, len(departments))
+
+	for _, department := range departments {
+		departmentsByID[department.ID] = department
+	}
+
+	out := make([]EmployeeWithDepartment, len(employees))
+
+	for i, employee := range employees {
+		out[i] = EmployeeWithDepartment{
+			Employee:          employee,
+			PrimaryDepartment: departmentsByID[employee.PrimaryDepartmentID],
+			CurrentDepartment: departmentsByID[employee.CurrentDepartmentID],
+		}
+	}
+
+	return out, nil
+}
+
+// ListWithTimeToday is a client-side join of concurrent employee and clock
+// event list calls, with today in Config.Timezone.
+func (c *employeeClient) ListWithTimeToday(ctx context.Context) ([]EmployeeWithTimeToday, error) {
+	now := c.now()
+
+	employees, summaries, err := c.listSummarized(ctx, dayRange(now), now)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]EmployeeWithTimeToday, len(employees))
+
+	for i, employee := range employees {
+		out[i] = EmployeeWithTimeToday{
+			Employee:              employee,
+			MinutesWorkedToday:    int(summaries[i].worked.Minutes()),
+			CurrentSessionMinutes: int(summaries[i].currentSession.Minutes()),
+		}
+	}
+
+	return out, nil
+}
+
+// ListWithHoursThisWeek is a client-side join of concurrent employee and clock
+// event list calls, with weeks starting on Monday in Config.Timezone.
+func (c *employeeClient) ListWithHoursThisWeek(ctx context.Context) ([]EmployeeWithHours, error) {
+	now := c.now()
+
+	employees, summaries, err := c.listSummarized(ctx, weekRange(now), now)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]EmployeeWithHours, len(employees))
+
+	for i, employee := range employees {
+		out[i] = EmployeeWithHours{
+			Employee:        employee,
+			MinutesThisWeek: int(summaries[i].worked.Minutes()),
+		}
+	}
+
+	return out, nil
+}
+
+// WorkloadComparison is a client-side join of concurrent employee and clock
+// event list calls, filtered to the department as in ListByDepartment.
+func (c *employeeClient) WorkloadComparison(ctx context.Context, departmentID string, r DateRange) ([]EmployeeWorkload, error) {
+	employees, summaries, err := c.listSummarized(ctx, r, c.now())
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		out        []EmployeeWorkload
+		maxMinutes int
+	)
+
+	for i, employee := range employees {
+		if employee.PrimaryDepartmentID != departmentID {
+			continue
+		}
+
+		minutes := int(summaries[i].worked.Minutes())
+		maxMinutes = max(maxMinutes, minutes)
+
+		out = append(out, EmployeeWorkload{
+			Employee:     employee,
+			TotalMinutes: minutes,
+		})
+	}
+
+	if maxMinutes > 0 {
+		for i := range out {
+			out[i].PercentOfMax = float64(out[i].TotalMinutes) / float64(maxMinutes) * 100
+		}
+	}
+
+	sort.SliceStable(out, func(i, j int) bool {
+		return out[i].TotalMinutes > out[j].TotalMinutes
+	})
+
+	return out, nil
+}
+
+// listSummarized concurrently lists all employees and their clock events
+// within r and summarizes the time of each employee, by index.
+func (c *employeeClient) listSummarized(ctx context.Context, r DateRange, now time.Time) ([]Employee, []timeSummary, error) {
+	var (
+		employees []Employee
+		events    []ClockEvent
+	)
+
+	err := runConcurrently(
+		func() (err error) {
+			employees, err = c.List(ctx)
+			return err
+		},
+		func() (err error) {
+			events, err = c.clockEvents.List(ctx, &ClockEventListRequest{
+				Start: r.Start,
+				End:   r.End,
+			})
+			return err
+		},
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	eventsByEmployee := groupClockEventsByEmployee(events)
+
+	summaries := make([]timeSummary, len(employees))
+
+	for i, employee := range employees {
+		summaries[i] = summarizeClockEvents(eventsByEmployee[employee.ID], employee.Status, r, now)
+	}
+
+	return employees, summaries, nil
+}
+
+// GetMostRecentPunch lists the full punch history of the employee, as
+// ClockEventClient does not support sorting or limiting clock events.
+func (c *employeeClient) GetMostRecentPunch(ctx context.Context, employeeID string) (*ClockEvent, error) {
+	events, err := c.clockEvents.List(ctx, &ClockEventListRequest{EmployeeID: employeeID})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(events) == 0 {
+		return nil, &Error{ErrorCode: http.StatusNotFound, ErrorText: "no punch history found"}
+	}
+
+	events = sortedClockEvents(events)
+
+	return &events[len(events)-1], nil
+}
+
+// ListNotWorkedSince lists the full punch history of all employees to find
+// their last punch, as the MyTimeStation API does not provide it. Employees
+// who are still clocked in are considered to have worked since.
+func (c *employeeClient) ListNotWorkedSince(ctx context.Context, since time.Time) ([]Employee, error) {
+	var (
+		employees []Employee
+		events    []ClockEvent
+	)
+
+	err := runConcurrently(
+		func() (err error) {
+			employees, err = c.List(ctx)
+			return err
+		},
+		func() (err error) {
+			events, err = c.clockEvents.List(ctx, nil)
+			return err
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	lastPunch := make(map[string]time.Time)
+
+	for _, event := range events {
+		if event.Timestamp.After(lastPunch[event.EmployeeID]) {
+			lastPunch[event.EmployeeID] = event.Timestamp
+		}
+	}
+
+	var out []Employee
+
+	for _, employee := range employees {
+		if employee.Status == EmployeeOutStatus && lastPunch[employee.ID].Before(since) {
+			out = append(out, employee)
+		}
+	}
+
+	// employees who never punched have a zero last punch so are sorted first
+	sort.SliceStable(out, func(i, j int) bool {
+		return lastPunch[out[i].ID].Before(lastPunch[out[j].ID])
+	})
+
+	return out, nil
+}
+
+// ListByStatus filters client-side as the MyTimeStation API does not support
+// filtering employees by status.
+func (c *employeeClient) ListByStatus(ctx context.Context, status EmployeeStatus) ([]Employee, error) {
+	if err := status.Validate(); err != nil {
+		return nil, err
+	}
+
+	employees, err := c.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var out []Employee
+
+	for _, employee := range employees {
+		if employee.Status == status {
+			out = append(out, employee)
+		}
+	}
+
+	return out, nil
+}
+
+// ListActive filters client-side so that employees on a break, who are still
+// clocked in, are included alongside those with EmployeeInStatus.
+func (c *employeeClient) ListActive(ctx context.Context) ([]Employee, error) {
+	employees, err := c.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var out []Employee
+
+	for _, employee := range employees {
+		if employee.Status.IsClockedIn() {
+			out = append(out, employee)
+		}
+	}
+
+	return out, nil
+}
+
+func (c *employeeClient) ListInactive(ctx context.Context) ([]Employee, error) {
+	return c.ListByStatus(ctx, EmployeeOutStatus)
+}
+
+// ListByDepartmentStatus groups client-side as the MyTimeStation API does not
+// support filtering or grouping employees. Departments with no employees
+// clocked in are not included.
+func (c *employeeClient) ListByDepartmentStatus(ctx context.Context) (map[string][]Employee, error) {
+	employees, err := c.ListActive(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make(map[string][]Employee)
+
+	for _, employee := range employees {
+		out[employee.CurrentDepartmentID] = append(out[employee.CurrentDepartmentID], employee)
+	}
+
+	return out, nil
+}
+
+// ListByHourlyRateRange filters client-side as the MyTimeStation API does not
+// support filtering employees by hourly rate. Rates are read with
+// GetHourlyRate, so employees without a rate have a rate of 0.
+func (c *employeeClient) ListByHourlyRateRange(ctx context.Context, minRate, maxRate float64) ([]Employee, error) {
+	if minRate > maxRate {
+		return nil, &ValidationError{Field: "min_rate", Reason: "must not exceed max_rate"}
+	}
+
+	employees, err := c.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var out []Employee
+
+	for _, employee := range employees {
+		rate, err := employee.GetHourlyRate()
+		if err != nil {
+			return nil, fmt.Errorf("employee %q: %w", employee.ID, err)
+		}
+
+		if rate >= minRate && rate <= maxRate {
+			out = append(out, employee)
+		}
+	}
+
+	return out, nil
+}
+
+// ListByDepartment filters client-side as the MyTimeStation API does not
+// support filtering employees by department.
+func (c *employeeClient) ListByDepartment(ctx context.Context, departmentID string) ([]Employee, error) {
+	employees, err := c.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var out []Employee
+
+	for _, employee := range employees {
+		if employee.PrimaryDepartmentID == departmentID {
+			out = append(out, employee)
+		}
+	}
+
+	return out, nil
+}
+
+// Count is currently backed by List as the MyTimeStation API does not
+// paginate; it should switch to a single item page once it does.
+func (c *employeeClient) Count(ctx context.Context) (int, error) {
+	employees, err := c.List(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	return len(employees), nil
+}
+
+func (c *employeeClient) SetPIN(ctx context.Context, employeeID, pin string) (*Employee, error) {
+	if err := validatePIN(pin); err != nil {
+		return nil, err
+	}
+
+	return c.Update(ctx, employeeID, &EmployeeUpdateRequest{PIN: &pin})
+}
+
+func (c *employeeClient) SetEmail(ctx context.Context, employeeID, email string) (*Employee, error) {
+	if !emailPattern.MatchString(email) {
+		return nil, &ValidationError{Field: "email", Reason: "must be a valid email address"}
+	}
+
+	return c.PatchCustomFields(ctx, employeeID, map[string]string{emailCustomField: email})
+}
+
+// SetCustomFields gets the employee to find the custom fields to remove, then
+// updates them. The update is conditional on the employee's ETag, if the
+// MyTimeStation API returns one; otherwise a concurrent change between the get
+// and the update may be lost.
+func (c *employeeClient) SetCustomFields(ctx context.Context, employeeID string, fields map[string]string) (*Employee, error) {
+	employee, etag, err := c.GetAndLock(ctx, employeeID)
+	if err != nil {
+		return nil, err
+	}
+
+	var deleted []string
+
+	for key := range employee.CustomFields {
+		if _, ok := fields[key]; !ok {
+			deleted = append(deleted, key)
+		}
+	}
+
+	return c.Update(ctx, employeeID, &EmployeeUpdateRequest{
+		CustomFields:       fields,
+		DeleteCustomFields: deleted,
+		IfMatch:            etag,
+	})
+}
+
+// PatchCustomFields gets the employee and merges fields into their custom
+// fields, then updates them. The update is conditional on the employee's ETag,
+// if the MyTimeStation API returns one; otherwise a concurrent change between
+// the get and the update may be lost.
+func (c *employeeClient) PatchCustomFields(ctx context.Context, employeeID string, fields map[string]string) (*Employee, error) {
+	employee, etag, err := c.GetAndLock(ctx, employeeID)
+	if err != nil {
+		return nil, err
+	}
+
+	merged := make(map[string]string, len(employee.CustomFields)+len(fields))
+
+	maps.Copy(merged, employee.CustomFields)
+	maps.Copy(merged, fields)
+
+	return c.Update(ctx, employeeID, &EmployeeUpdateRequest{
+		CustomFields: merged,
+		IfMatch:      etag,
+	})
+}
+
+// ListSecondaryDepartments resolves any departments only returned by ID with an
+// extra department list call.
+func (c *employeeClient) ListSecondaryDepartments(ctx context.Context, employeeID string) ([]Department, error) {
+	employee, err := c.Get(ctx, employeeID)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(employee.SecondaryDepartmentIDs) <= len(employee.SecondaryDepartments) {
+		return employee.SecondaryDepartments, nil
+	}
+
+	departments, err := c.departments.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	departmentsByID := make(map[string]Department, len(departments))
+
+	for _, department := range departments {
+		departmentsByID[department.ID] = department
+	}
+
+	out := make([]Department, 0, len(employee.SecondaryDepartmentIDs))
+
+	for _, id := range employee.SecondaryDepartmentIDs {
+		if department, ok := departmentsByID[id]; ok {
+			out = append(out, department)
+		}
+	}
+
+	return out, nil
+}
+
+func (c *employeeClient) SetDepartment(ctx context.Context, employeeID, departmentID string, keepOldAsSecondary bool) (*Employee, error) {
+	return c.Update(ctx, employeeID, &EmployeeUpdateRequest{
+		DepartmentID:             &departmentID,
+		ConvertPrimaryDepartment: &keepOldAsSecondary,
+	})
+}
+
+// BatchAssignDepartment calls SetDepartment for each employee, bounded by
+// Config.BatchConcurrency. Once ctx is done, no further calls are started and
+// ctx.Err() is returned once calls in flight complete; employees not reached
+// have a failed result with ctx.Err().
+func (c *employeeClient) BatchAssignDepartment(ctx context.Context, employeeIDs []string, departmentID string, keepOldAsSecondary bool) ([]BatchResult, error) {
+	errs := runBounded(ctx, c.conf.GetBatchConcurrency(), len(employeeIDs), func(i int) error {
+		_, err := c.SetDepartment(ctx, employeeIDs[i], departmentID, keepOldAsSecondary)
+		return err
+	})
+
+	out := make([]BatchResult, len(employeeIDs))
+
+	for i, id := range employeeIDs {
+		out[i] = BatchResult{EmployeeID: id, Success: errs[i] == nil, Err: errs[i]}
+	}
+
+	return out, ctx.Err()
+}
+
+// ImportFromJSON decodes the whole array before creating any employees, so
+// malformed JSON creates none. Employees are created concurrently, bounded by
+// Config.BatchConcurrency, and each error is prefixed with the index of the
+// request in the array.
+func (c *employeeClient) ImportFromJSON(ctx context.Context, r io.Reader) (int, []error) {
+	var reqs []EmployeeCreateRequest
+
+	if err := json.NewDecoder(r).Decode(&reqs); err != nil {
+		return 0, []error{fmt.Errorf("decode employees: %w", err)}
+	}
+
+	errs := runBounded(ctx, c.conf.GetBatchConcurrency(), len(reqs), func(i int) error {
+		_, err := c.Create(ctx, &reqs[i])
+		return err
+	})
+
+	var (
+		imported int
+		out      []error
+	)
+
+	for i, err := range errs {
+		if err != nil {
+			out = append(out, fmt.Errorf("employee %d: %w", i, err))
+			continue
+		}
+
+		imported++
+	}
+
+	return imported, out
+}
+
+func (c *employeeClient) AssignOvertimePolicy(ctx context.Context, employeeID, policyID string) (*Employee, error) {
+	return c.Update(ctx, employeeID, &EmployeeUpdateRequest{OvertimePolicyID: &policyID})
+}
+
+// WorkMinutesToday derives the minutes worked from clock events, with today in
+// Config.Timezone.
+func (c *employeeClient) WorkMinutesToday(ctx context.Context, employeeID string) (int, error) {
+	now := c.now()
+
+	_, summary, err := c.summarizeEmployeeTime(ctx, employeeID, dayRange(now), now)
+	if err != nil {
+		return 0, err
+	}
+
+	return int(summary.worked.Minutes()), nil
+}
+
+// GetWorkdayMinutes derives the minutes worked from clock events, with the day
+// running from midnight to midnight in Config.Timezone.
+func (c *employeeClient) GetWorkdayMinutes(ctx context.Context, employeeID string, date time.Time) (int, error) {
+	summary, err := c.summarizeWorkday(ctx, employeeID, date)
+	if err != nil {
+		return 0, err
+	}
+
+	return int(summary.worked.Minutes()), nil
+}
+
+// GetWorkdayBreakMinutes derives the minutes on a break from clock events, with
+// the day running from midnight to midnight in Config.Timezone.
+func (c *employeeClient) GetWorkdayBreakMinutes(ctx context.Context, employeeID string, date time.Time) (int, error) {
+	summary, err := c.summarizeWorkday(ctx, employeeID, date)
+	if err != nil {
+		return 0, err
+	}
+
+	return int(summary.onBreak.Minutes()), nil
+}
+
+// summarizeWorkday summarizes the time of an employee by id on the day of date
+// in Config.Timezone.
+func (c *employeeClient) summarizeWorkday(ctx context.Context, employeeID string, date time.Time) (timeSummary, error) {
+	now := c.now()
+
+	_, summary, err := c.summarizeEmployeeTime(ctx, employeeID, dayRange(date.In(c.conf.GetTimezone())), now)
+
+	return summary, err
+}
+
+// summarizeEmployeeTime concurrently gets an employee by id and their clock
+// events within r and summarizes them.
+func (c *employeeClient) summarizeEmployeeTime(ctx context.Context, employeeID string, r DateRange, now time.Time) (*Employee, timeSummary, error) {
+	var (
+		employee *Employee
+		events   []ClockEvent
+	)
+
+	err := runConcurrently(
+		func() (err error) {
+			employee, err = c.Get(ctx, employeeID)
+			return err
+		},
+		func() (err error) {
+			events, err = c.clockEvents.List(ctx, &ClockEventListRequest{
+				EmployeeID: employeeID,
+				Start:      r.Start,
+				End:        r.End,
+			})
+			return err
+		},
+	)
+	if err != nil {
+		return nil, timeSummary{}, err
+	}
+
+	return employee, summarizeClockEvents(events, employee.Status, r, now), nil
+}
+
+// IsNearHoursCap derives the minutes worked from clock events, with weeks
+// starting on Monday in Config.Timezone.
+func (c *employeeClient) IsNearHoursCap(ctx context.Context, employeeID string, threshold float64) (bool, error) {
+	now := c.now()
+
+	employee, summary, err := c.summarizeEmployeeTime(ctx, employeeID, weekRange(now), now)
+	if err != nil {
+		return false, err
+	}
+
+	if employee.MaxWeeklyMinutes == nil || *employee.MaxWeeklyMinutes <= 0 {
+		return false, nil
+	}
+
+	return summary.worked.Minutes() >= float64(*employee.MaxWeeklyMinutes)*threshold/100, nil
+}
+
+// fullTimeWeeklyMinutes is the minimum average minutes worked per week for an
+// employee to be considered full-time.
+const fullTimeWeeklyMinutes = 30 * 60
+
+// IsFullTime derives the minutes worked from clock events over the complete
+// weeks before the current one, with weeks starting on Monday in
+// Config.Timezone.
+func (c *employeeClient) IsFullTime(ctx context.Context, employeeID string, weeks int) (bool, error) {
+	if weeks < 1 {
+		return false, &ValidationError{Field: "weeks", Reason: "must be at least 1"}
+	}
+
+	now := c.now()
+	end := weekRange(now).Start
+	r := DateRange{Start: end.AddDate(0, 0, -7*weeks), End: end}
+
+	_, summary, err := c.summarizeEmployeeTime(ctx, employeeID, r, now)
+	if err != nil {
+		return false, err
+	}
+
+	return summary.worked.Minutes()/float64(weeks) >= fullTimeWeeklyMinutes, nil
+}
+
+func (c *employeeClient) IsPINUnique(ctx context.Context, pin string) (bool, error) {
+	employees, err := c.List(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	for _, employee := range employees {
+		if employee.PIN == pin {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
+// generatePIN generates a cryptographically random 4-digit PIN.
+func generatePIN() (string, error) {
+	n, err := rand.Int(rand.Reader, big.NewInt(10000))
+	if err != nil {
+		return "", fmt.Errorf("could not generate pin: %w", err)
+	}
+
+	return fmt.Sprintf("%04d", n.Int64()), nil
+}
+
+// emailPattern loosely matches an email address: a local part, an @ and a
+// domain with at least one dot, without whitespace.
+var emailPattern = regexp.MustCompile(`^[^@\s]+@[^@\s]+\.[^@\s.]+$`)
+
+// validatePIN returns a *ValidationError if pin is not exactly 4 digits.
+func validatePIN(pin string) error {
+	if len(pin) != 4 {
+		return &ValidationError{Field: "pin", Reason: "must be exactly 4 digits"}
+	}
+
+	for _, r := range pin {
+		if r < '0' || r > '9' {
+			return &ValidationError{Field: "pin", Reason: "must be exactly 4 digits"}
+		}
+	}
+
+	return nil
 }
 
 // compile-time assertion that employeeClient implementation fulfils
@@ -0,0 +1,130 @@
+package gomts
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"time"
+)
+
+// EmployeeChange records the fields of an employee changed by a single
+// EmployeeClient.Update call. Written as a JSON line to
+// Config.EmployeeChangeLog.
+type EmployeeChange struct {
+	// EmployeeID is the ID of the updated employee.
+	EmployeeID string `json:"employee_id"`
+
+	// ChangedAt is when the update completed.
+	ChangedAt time.Time `json:"changed_at"`
+
+	// Changes are the changed fields keyed by their JSON name. PINs are
+	// redacted.
+	Changes map[string]EmployeeFieldChange `json:"changes"`
+}
+
+// EmployeeFieldChange is the value of a single employee field before and after
+// an update.
+type EmployeeFieldChange struct {
+	Old any `json:"old"`
+	New any `json:"new"`
+}
+
+// diffEmployees returns the fields which differ between before and after,
+// keyed by their JSON name.
+func diffEmployees(before, after *Employee) (map[string]EmployeeFieldChange, error) {
+	prev, err := employeeFields(before)
+	if err != nil {
+		return nil, err
+	}
+
+	next, err := employeeFields(after)
+	if err != nil {
+		return nil, err
+	}
+
+	changes := make(map[string]EmployeeFieldChange)
+
+	for key, value := range next {
+		if !reflect.DeepEqual(prev[key], value) {
+			changes[key] = EmployeeFieldChange{Old: prev[key], New: value}
+		}
+	}
+
+	for key, value := range prev {
+		if _, ok := next[key]; !ok {
+			changes[key] = EmployeeFieldChange{Old: value}
+		}
+	}
+
+	// record that the PIN changed without writing either value
+	if change, ok := changes["pin"]; ok {
+		changes["pin"] = EmployeeFieldChange{Old: maskPIN(change.Old), New: maskPIN(change.New)}
+	}
+
+	return changes, nil
+}
+
+// maskPIN replaces a non-empty PIN with redactedPIN.
+func maskPIN(pin any) any {
+	if s, ok := pin.(string); ok && s != "" {
+		return redactedPIN
+	}
+
+	return pin
+}
+
+// employeeFields returns the JSON representation of e as a map.
+func employeeFields(e *Employee) (map[string]any, error) {
+	b, err := json.Marshal(e)
+	if err != nil {
+		return nil, err
+	}
+
+	var fields map[string]any
+
+	if err := json.Unmarshal(b, &fields); err != nil {
+		return nil, err
+	}
+
+	return fields, nil
+}
+
+// updateWithChangeLog wraps an update by getting the employee beforehand and
+// writing the resulting changes to Config.EmployeeChangeLog. The update is not
+// made if the employee cannot be fetched. If the update succeeds but the
+// change cannot be written, the updated employee is returned with the error.
+func (c *employeeClient) updateWithChangeLog(ctx context.Context, id string, update func() (*Employee, error)) (*Employee, error) {
+	before, err := c.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	after, err := update()
+	if err != nil {
+		return nil, err
+	}
+
+	changes, err := diffEmployees(before, after)
+	if err != nil {
+		return after, fmt.Errorf("gomts: failed to diff employee: %w", err)
+	}
+
+	if len(changes) == 0 {
+		return after, nil
+	}
+
+	c.changeLogMtx.Lock()
+	defer c.changeLogMtx.Unlock()
+
+	err = json.NewEncoder(c.conf.EmployeeChangeLog).Encode(&EmployeeChange{
+		EmployeeID: id,
+		ChangedAt:  time.Now(),
+		Changes:    changes,
+	})
+	if err != nil {
+		return after, fmt.Errorf("gomts: failed to write employee change log: %w", err)
+	}
+
+	return after, nil
+}
@@ -0,0 +1,139 @@
+package gomts
+
+import (
+	"context"
+	"maps"
+	"slices"
+	"sync"
+)
+
+// EmployeeIndex is a concurrency-safe, in-memory index of employees keyed by
+// PIN, custom employee ID and card number.
+//
+// The index is a point-in-time snapshot of the employees it was built from. It
+// is not kept in sync with MyTimeStation and must be refreshed explicitly.
+type EmployeeIndex struct {
+	// mtx protects the following resources
+	mtx          *sync.RWMutex
+	employees    []Employee
+	byPIN        map[string]*Employee
+	byCustomID   map[string]*Employee
+	byCardNumber map[string]*Employee
+}
+
+// NewEmployeeIndex builds a new EmployeeIndex from the given employees.
+func NewEmployeeIndex(employees []Employee) *EmployeeIndex {
+	idx := &EmployeeIndex{
+		mtx:          new(sync.RWMutex),
+		byPIN:        make(map[string]*Employee),
+		byCustomID:   make(map[string]*Employee),
+		byCardNumber: make(map[string]*Employee),
+	}
+
+	idx.build(employees)
+
+	return idx
+}
+
+// Refresh lists all employees with the given client and rebuilds the index in
+// place. Lookups made while the list call is in flight are served from the
+// previous snapshot; the new snapshot becomes visible all at once.
+func (idx *EmployeeIndex) Refresh(ctx context.Context, client EmployeeClient) error {
+	employees, err := client.List(ctx)
+	if err != nil {
+		return err
+	}
+
+	idx.mtx.Lock()
+	defer idx.mtx.Unlock()
+
+	// reuse the existing maps rather than allocating new ones
+	clear(idx.byPIN)
+	clear(idx.byCustomID)
+	clear(idx.byCardNumber)
+
+	idx.build(employees)
+
+	return nil
+}
+
+// GetByPIN looks up an employee by PIN.
+func (idx *EmployeeIndex) GetByPIN(pin string) (*Employee, bool) {
+	return idx.get(idx.byPIN, pin)
+}
+
+// GetByCustomID looks up an employee by custom employee ID.
+func (idx *EmployeeIndex) GetByCustomID(id string) (*Employee, bool) {
+	return idx.get(idx.byCustomID, id)
+}
+
+// GetByCardNumber looks up an employee by card number.
+func (idx *EmployeeIndex) GetByCardNumber(n string) (*Employee, bool) {
+	return idx.get(idx.byCardNumber, n)
+}
+
+func (idx *EmployeeIndex) get(m map[string]*Employee, key string) (*Employee, bool) {
+	if key == "" {
+		// empty keys are never indexed
+		return nil, false
+	}
+
+	idx.mtx.RLock()
+	defer idx.mtx.RUnlock()
+
+	employee, ok := m[key]
+	if !ok {
+		return nil, false
+	}
+
+	// return a deep copy so callers cannot mutate the index
+	out := copyEmployee(*employee)
+
+	return &out, true
+}
+
+// build populates the lookup maps from employees. Callers must hold the write
+// lock or have exclusive access to the index.
+func (idx *EmployeeIndex) build(employees []Employee) {
+	// copy so later changes by the caller do not alter the snapshot
+	idx.employees = make([]Employee, len(employees))
+	for i, employee := range employees {
+		idx.employees[i] = copyEmployee(employee)
+	}
+
+	for i := range idx.employees {
+		employee := &idx.employees[i]
+
+		if employee.PIN != "" {
+			idx.byPIN[employee.PIN] = employee
+		}
+
+		if employee.CustomEmployeeID != "" {
+			idx.byCustomID[employee.CustomEmployeeID] = employee
+		}
+
+		if employee.CardNumber != "" {
+			idx.byCardNumber[employee.CardNumber] = employee
+		}
+	}
+}
+
+// copyEmployee returns a deep copy of e which shares no slices, maps or
+// pointers with it.
+func copyEmployee(e Employee) Employee {
+	e.SecondaryDepartments = slices.Clone(e.SecondaryDepartments)
+	e.SecondaryDepartmentIDs = slices.Clone(e.SecondaryDepartmentIDs)
+	e.CustomFields = maps.Clone(e.CustomFields)
+
+	if e.MaxWeeklyMinutes != nil {
+		maxWeeklyMinutes := *e.MaxWeeklyMinutes
+		e.MaxWeeklyMinutes = &maxWeeklyMinutes
+	}
+
+	if e.StartDate != nil {
+		startDate := *e.StartDate
+		e.StartDate = &startDate
+	}
+
+	return e
+}
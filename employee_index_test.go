@@ -0,0 +1,104 @@
+package gomts_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.charbar.io/gomts"
+)
+
+func TestEmployeeIndex(t *testing.T) {
+	idx := gomts.NewEmployeeIndex([]gomts.Employee{
+		{ID: "emp_1", PIN: "1234", CustomEmployeeID: "A-1", CardNumber: "1001"},
+		{ID: "emp_2", PIN: "5678"},
+	})
+
+	employee, ok := idx.GetByPIN("5678")
+	assert.True(t, ok)
+	assert.Equal(t, "emp_2", employee.ID)
+
+	employee, ok = idx.GetByCustomID("A-1")
+	assert.True(t, ok)
+	assert.Equal(t, "emp_1", employee.ID)
+
+	employee, ok = idx.GetByCardNumber("1001")
+	assert.True(t, ok)
+	assert.Equal(t, "emp_1", employee.ID)
+
+	_, ok = idx.GetByCustomID("")
+	assert.False(t, ok)
+
+	_, ok = idx.GetByPIN("0000")
+	assert.False(t, ok)
+}
+
+func TestEmployeeIndexSnapshot(t *testing.T) {
+	employees := []gomts.Employee{
+		{ID: "emp_1", PIN: "1234", CustomFields: map[string]string{"team": "a"}, SecondaryDepartmentIDs: []string{"dep_2"}},
+	}
+
+	idx := gomts.NewEmployeeIndex(employees)
+
+	// changes by the caller after building are not seen by the index
+	employees[0].ID = "emp_2"
+	employees[0].CustomFields["team"] = "b"
+	employees[0].SecondaryDepartmentIDs[0] = "dep_3"
+
+	employee, ok := idx.GetByPIN("1234")
+	if assert.True(t, ok) {
+		assert.Equal(t, "emp_1", employee.ID)
+		assert.Equal(t, map[string]string{"team": "a"}, employee.CustomFields)
+		assert.Equal(t, []string{"dep_2"}, employee.SecondaryDepartmentIDs)
+
+		// nor are changes to returned employees
+		employee.CustomFields["team"] = "c"
+		employee.SecondaryDepartmentIDs[0] = "dep_4"
+	}
+
+	employee, ok = idx.GetByPIN("1234")
+	if assert.True(t, ok) {
+		assert.Equal(t, map[string]string{"team": "a"}, employee.CustomFields)
+		assert.Equal(t, []string{"dep_2"}, employee.SecondaryDepartmentIDs)
+	}
+}
+
+func TestEmployeeIndexRefresh(t *testing.T) {
+	idx := gomts.NewEmployeeIndex([]gomts.Employee{
+		{ID: "emp_1", PIN: "1234", CustomEmployeeID: "A-1", CardNumber: "1001"},
+	})
+
+	client := fakeServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		// emp_1 was deleted and its PIN reassigned
+		fmt.Fprint(w, `{"employees": [{"employee_id": "emp_2", "pin": "1234", "card_number": "1002"}]}`)
+	})
+
+	assert.NoError(t, idx.Refresh(context.Background(), client.Employees()))
+
+	employee, ok := idx.GetByPIN("1234")
+	assert.True(t, ok)
+	assert.Equal(t, "emp_2", employee.ID)
+
+	employee, ok = idx.GetByCardNumber("1002")
+	assert.True(t, ok)
+	assert.Equal(t, "emp_2", employee.ID)
+
+	// stale entries are gone
+	_, ok = idx.GetByCustomID("A-1")
+	assert.False(t, ok)
+
+	_, ok = idx.GetByCardNumber("1001")
+	assert.False(t, ok)
+
+	// the index is left unchanged if the list fails
+	failing := fakeServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+
+	assert.Error(t, idx.Refresh(context.Background(), failing.Employees()))
+
+	_, ok = idx.GetByPIN("1234")
+	assert.True(t, ok)
+}
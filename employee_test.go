@@ -1,10 +1,20 @@
 package gomts_test
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
+	"fmt"
+	"io"
 	"math/rand"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
 	"testing"
+	"time"
 
+	"github.com/google/go-querystring/query"
 	"github.com/stretchr/testify/assert"
 	"go.charbar.io/gomts"
 )
@@ -30,9 +40,10 @@ func TestEmployeesCreate(t *testing.T) {
 	assert.NoError(t, err)
 
 	createRequest := &gomts.EmployeeCreateRequest{
-		Name:  testResourceName("bob ross"),
-		PIN:   randomPin(),
-		Title: "Senior Artist",
+		Name:       testResourceName("bob ross"),
+		PIN:        randomPin(),
+		Title:      "Senior Artist",
+		HourlyRate: 25.50,
 
 		DepartmentID: dept.ID,
 	}
@@ -46,7 +57,1539 @@ func TestEmployeesCreate(t *testing.T) {
 	assert.Equal(t, createRequest.Name, employee.Name)
 	assert.Equal(t, createRequest.PIN, employee.PIN)
 	assert.Equal(t, createRequest.Title, employee.Title)
+	assert.Equal(t, createRequest.HourlyRate, employee.HourlyRate)
 	assert.NotEmpty(t, employee.CardNumber)
 	assert.NotEmpty(t, employee.CardQRCode)
 	assert.NotEmpty(t, employee.PrimaryDepartment)
 }
+
+func TestEmployeeUpdateRequestDeleteCustomFields(t *testing.T) {
+	req := &gomts.EmployeeUpdateRequest{
+		CustomFields:       map[string]string{"phone": "555-0100", "email": "bob@example.com"},
+		DeleteCustomFields: []string{"start_date", "email"},
+	}
+
+	b, err := json.Marshal(req)
+	assert.NoError(t, err)
+
+	assert.JSONEq(t, `{
+		"custom_fields": {
+			"phone": "555-0100",
+			"email": null,
+			"start_date": null
+		}
+	}`, string(b))
+}
+
+func TestEmployeeUpdateRequestWith(t *testing.T) {
+	req := new(gomts.EmployeeUpdateRequest).
+		WithName("bob ross").
+		WithDepartmentID("dep_1").
+		WithHourlyRate(20.5).
+		WithConvertPrimaryDepartment(true)
+
+	b, err := json.Marshal(req)
+	assert.NoError(t, err)
+
+	assert.JSONEq(t, `{
+		"name": "bob ross",
+		"department_id": "dep_1",
+		"hourly_rate": 20.5,
+		"convert_primary_department": true
+	}`, string(b))
+}
+
+func TestEmployeeUpdateRequestMarshal(t *testing.T) {
+	for name, tc := range map[string]struct {
+		req  gomts.EmployeeUpdateRequest
+		want string
+	}{
+		"nil pointers are omitted": {
+			req:  gomts.EmployeeUpdateRequest{},
+			want: `{}`,
+		},
+		"zero values are explicit": {
+			req: gomts.EmployeeUpdateRequest{
+				Name:                     new(string),
+				DepartmentID:             new(string),
+				DepartmentName:           new(string),
+				CustomEmployeeID:         new(string),
+				Title:                    new(string),
+				HourlyRate:               new(float64),
+				PIN:                      new(string),
+				OvertimePolicyID:         new(string),
+				MaxWeeklyMinutes:         new(int),
+				ConvertPrimaryDepartment: new(bool),
+			},
+			want: `{
+				"name": "",
+				"department_id": "",
+				"department_name": "",
+				"custom_employee_id": "",
+				"title": "",
+				"hourly_rate": 0,
+				"pin": "",
+				"overtime_policy_id": "",
+				"max_weekly_minutes": 0,
+				"convert_primary_department": false
+			}`,
+		},
+		"convert primary department": {
+			req:  *new(gomts.EmployeeUpdateRequest).WithConvertPrimaryDepartment(true),
+			want: `{"convert_primary_department": true}`,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			b, err := json.Marshal(tc.req)
+			assert.NoError(t, err)
+
+			assert.JSONEq(t, tc.want, string(b))
+		})
+	}
+}
+
+func TestEmployeeUpdateRequestClear(t *testing.T) {
+	req := &gomts.EmployeeUpdateRequest{CustomFields: map[string]string{"team": "blue"}}
+
+	req.WithName("bob ross").WithTitle("Painter").Clear("team", "phone")
+
+	b, err := json.Marshal(req)
+	assert.NoError(t, err)
+
+	assert.JSONEq(t, `{
+		"name": "bob ross",
+		"custom_employee_id": "",
+		"title": "",
+		"hourly_rate": 0,
+		"pin": "",
+		"overtime_policy_id": "",
+		"max_weekly_minutes": 0,
+		"start_date": null,
+		"custom_fields": {
+			"team": null,
+			"phone": null
+		}
+	}`, string(b))
+
+	// without keys, custom fields are left unchanged
+	b, err = json.Marshal(new(gomts.EmployeeUpdateRequest).Clear())
+	assert.NoError(t, err)
+	assert.NotContains(t, string(b), "custom_fields")
+}
+
+func TestEmployeeCreateRequestSecondaryDepartments(t *testing.T) {
+	values, err := query.Values(&gomts.EmployeeCreateRequest{
+		Name:                   "bob ross",
+		SecondaryDepartmentIDs: []string{"X", "Y"},
+	})
+	assert.NoError(t, err)
+
+	assert.Equal(t, "name=bob+ross&secondary_department_ids%5B%5D=X&secondary_department_ids%5B%5D=Y", values.Encode())
+}
+
+func TestEmployeeCreateRequestURLEncoding(t *testing.T) {
+	maxWeeklyMinutes := 2400
+
+	values, err := query.Values(&gomts.EmployeeCreateRequest{
+		Name:                   "bob ross",
+		DepartmentID:           "dep_1",
+		DepartmentName:         "Painting",
+		SecondaryDepartmentIDs: []string{"dep_2"},
+		CustomEmployeeID:       "1234",
+		Title:                  "Painter",
+		HourlyRate:             20.5,
+		PIN:                    "5678",
+		MaxWeeklyMinutes:       &maxWeeklyMinutes,
+		StartDate:              gomts.NewDate(time.Date(2024, time.March, 9, 0, 0, 0, 0, time.UTC)),
+		GeneratePIN:            true,
+		CustomFields: gomts.FormCustomFields{
+			"phone": "555-0100",
+			"team":  "blue",
+		},
+	})
+	assert.NoError(t, err)
+
+	assert.Equal(t, url.Values{
+		"name":                       {"bob ross"},
+		"department_id":              {"dep_1"},
+		"department_name":            {"Painting"},
+		"secondary_department_ids[]": {"dep_2"},
+		"custom_employee_id":         {"1234"},
+		"title":                      {"Painter"},
+		"hourly_rate":                {"20.5"},
+		"pin":                        {"5678"},
+		"max_weekly_minutes":         {"2400"},
+		"start_date":                 {"2024-03-09"},
+		"custom_fields[phone]":       {"555-0100"},
+		"custom_fields[team]":        {"blue"},
+	}, values)
+
+	// custom fields use bracketed keys, like secondary department IDs
+	values, err = query.Values(&gomts.EmployeeCreateRequest{
+		Name:         "bob ross",
+		CustomFields: map[string]string{"team": "blue", "phone": "555-0100"},
+	})
+	assert.NoError(t, err)
+
+	assert.Equal(t, "custom_fields%5Bphone%5D=555-0100&custom_fields%5Bteam%5D=blue&name=bob+ross", values.Encode())
+}
+
+func TestParseEmployeeStatus(t *testing.T) {
+	status, err := gomts.ParseEmployeeStatus("in")
+	assert.NoError(t, err)
+	assert.Equal(t, gomts.EmployeeInStatus, status)
+
+	_, err = gomts.ParseEmployeeStatus("maybe")
+
+	var validationErr *gomts.ValidationError
+	assert.ErrorAs(t, err, &validationErr)
+	assert.Equal(t, "status", validationErr.Field)
+}
+
+func TestEmployeeHourlyRate(t *testing.T) {
+	employee := &gomts.Employee{HourlyRate: 25.5}
+
+	rate, err := employee.GetHourlyRate()
+	assert.NoError(t, err)
+	assert.Equal(t, 25.5, rate)
+	assert.Equal(t, 30.0, *employee.SetHourlyRate(30).HourlyRate)
+
+	employee = &gomts.Employee{CustomFields: map[string]string{"hourly_rate": "17.25"}}
+
+	rate, err = employee.GetHourlyRate()
+	assert.NoError(t, err)
+	assert.Equal(t, 17.25, rate)
+
+	req := employee.SetHourlyRate(18)
+	assert.Nil(t, req.HourlyRate)
+	assert.Equal(t, map[string]string{"hourly_rate": "18"}, req.CustomFields)
+
+	employee = &gomts.Employee{CustomFields: map[string]string{"hourly_rate": "lots"}}
+
+	_, err = employee.GetHourlyRate()
+	assert.Error(t, err)
+}
+
+func TestEmployeeCustomFields(t *testing.T) {
+	employee := &gomts.Employee{CustomFields: map[string]string{"phone": "", "team": "blue"}}
+
+	assert.True(t, employee.HasCustomField("phone"))
+	assert.False(t, employee.HasCustomField("email"))
+
+	value, err := employee.MustCustomField("team")
+	assert.NoError(t, err)
+	assert.Equal(t, "blue", value)
+
+	_, err = employee.MustCustomField("email")
+	assert.ErrorIs(t, err, gomts.ErrMissingCustomField)
+
+	assert.Equal(t, "", employee.CustomFieldOr("phone", "n/a"))
+	assert.Equal(t, "n/a", employee.CustomFieldOr("email", "n/a"))
+}
+
+func TestEmployeeGetEmail(t *testing.T) {
+	for _, tc := range []struct {
+		fields    map[string]string
+		wantEmail string
+		wantOK    bool
+	}{
+		{fields: map[string]string{"email": "bob@example.com"}, wantEmail: "bob@example.com", wantOK: true},
+		{fields: map[string]string{"email": ""}, wantEmail: "", wantOK: true},
+		{fields: map[string]string{"phone": "555-0100"}, wantEmail: "", wantOK: false},
+		{fields: nil, wantEmail: "", wantOK: false},
+	} {
+		email, ok := (&gomts.Employee{CustomFields: tc.fields}).GetEmail()
+		assert.Equal(t, tc.wantEmail, email)
+		assert.Equal(t, tc.wantOK, ok)
+	}
+}
+
+func TestEmployeeIsManager(t *testing.T) {
+	for _, tc := range []struct {
+		role      string
+		isManager string
+		want      bool
+	}{
+		{role: "manager", want: true},
+		{isManager: "true", want: true},
+		{role: "manager", isManager: "true", want: true},
+		{want: false},
+	} {
+		employee := &gomts.Employee{CustomFields: map[string]string{
+			"role":       tc.role,
+			"is_manager": tc.isManager,
+		}}
+
+		assert.Equal(t, tc.want, employee.IsManager(), "role %q, is_manager %q", tc.role, tc.isManager)
+	}
+}
+
+func TestEmployeeInDepartment(t *testing.T) {
+	employee := &gomts.Employee{
+		PrimaryDepartment:      "painters",
+		PrimaryDepartmentID:    "dep_1",
+		SecondaryDepartmentIDs: []string{"dep_2"},
+		SecondaryDepartments:   []gomts.Department{{ID: "dep_3", Name: "hosts"}},
+	}
+
+	assert.True(t, employee.InDepartment("dep_1"))
+	assert.True(t, employee.InDepartment("dep_2"))
+	assert.True(t, employee.InDepartment("dep_3"))
+	assert.False(t, employee.InDepartment("dep_4"))
+
+	assert.Equal(t, &gomts.Department{ID: "dep_1", Name: "painters"}, employee.PrimaryDept())
+
+	assert.True(t, employee.InDepartmentNamed("painters"))
+	assert.True(t, employee.InDepartmentNamed("hosts"))
+	assert.False(t, employee.InDepartmentNamed("janitors"))
+}
+
+func TestEmployeesListByStatus(t *testing.T) {
+	client, _ := integrationTest(t)
+
+	ctx := context.Background()
+
+	dept, err := client.Departments().Create(ctx, &gomts.DepartmentCreateRequest{
+		Name: testResourceName("something"),
+	})
+	assert.NoError(t, err)
+
+	newEmployee, err := client.Employees().Create(ctx, &gomts.EmployeeCreateRequest{
+		Name:         testResourceName("bob ross"),
+		DepartmentID: dept.ID,
+	})
+	assert.NoError(t, err)
+
+	for _, status := range []gomts.EmployeeStatus{
+		gomts.EmployeeInStatus,
+		gomts.EmployeeOutStatus,
+		gomts.EmployeeOnBreakStatus,
+	} {
+		t.Run(string(status), func(t *testing.T) {
+			employees, err := client.Employees().ListByStatus(ctx, status)
+			assert.NoError(t, err)
+
+			var found bool
+
+			for _, employee := range employees {
+				assert.Equal(t, status, employee.Status)
+				found = found || employee.ID == newEmployee.ID
+			}
+
+			// new employees start clocked out
+			assert.Equal(t, status == gomts.EmployeeOutStatus, found)
+		})
+	}
+
+	_, err = client.Employees().ListByStatus(ctx, "maybe")
+
+	var validationErr *gomts.ValidationError
+	assert.ErrorAs(t, err, &validationErr)
+}
+
+func TestEmployeesListActive(t *testing.T) {
+	client, _ := integrationTest(t)
+
+	ctx := context.Background()
+
+	dept, err := client.Departments().Create(ctx, &gomts.DepartmentCreateRequest{
+		Name: testResourceName("something"),
+	})
+	assert.NoError(t, err)
+
+	newEmployee, err := client.Employees().Create(ctx, &gomts.EmployeeCreateRequest{
+		Name:         testResourceName("bob ross"),
+		DepartmentID: dept.ID,
+	})
+	assert.NoError(t, err)
+
+	employees, err := client.Employees().ListActive(ctx)
+	assert.NoError(t, err)
+
+	for _, employee := range employees {
+		assert.True(t, employee.Status.IsClockedIn())
+
+		// new employees start clocked out
+		assert.NotEqual(t, newEmployee.ID, employee.ID)
+	}
+}
+
+func TestEmployeesListInactive(t *testing.T) {
+	client, _ := integrationTest(t)
+
+	ctx := context.Background()
+
+	dept, err := client.Departments().Create(ctx, &gomts.DepartmentCreateRequest{
+		Name: testResourceName("something"),
+	})
+	assert.NoError(t, err)
+
+	newEmployee, err := client.Employees().Create(ctx, &gomts.EmployeeCreateRequest{
+		Name:         testResourceName("bob ross"),
+		DepartmentID: dept.ID,
+	})
+	assert.NoError(t, err)
+
+	employees, err := client.Employees().ListInactive(ctx)
+	assert.NoError(t, err)
+
+	var found bool
+
+	for _, employee := range employees {
+		assert.Equal(t, gomts.EmployeeOutStatus, employee.Status)
+		found = found || employee.ID == newEmployee.ID
+	}
+
+	// new employees start clocked out
+	assert.True(t, found)
+}
+
+func TestEmployeesListActiveIncludesBreak(t *testing.T) {
+	client := fakeServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"employees": [
+			{"employee_id": "emp_1", "status": "in"},
+			{"employee_id": "emp_2", "status": "break"},
+			{"employee_id": "emp_3", "status": "out"},
+			{"employee_id": "emp_4", "status": "unknown"}
+		]}`)
+	})
+
+	employees, err := client.Employees().ListActive(context.Background())
+	assert.NoError(t, err)
+
+	var ids []string
+	for _, employee := range employees {
+		ids = append(ids, employee.ID)
+	}
+
+	assert.Equal(t, []string{"emp_1", "emp_2"}, ids)
+}
+
+func TestEmployeesListWithTimeToday(t *testing.T) {
+	now := time.Now()
+
+	if midnight := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location()); now.Sub(midnight) < time.Hour {
+		t.Skip("skipping as clock events would span midnight")
+	}
+
+	client := fakeServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v1.2/employees", r.URL.Path)
+
+		fmt.Fprint(w, `{"employees": [
+			{"employee_id": "emp_1", "status": "in"},
+			{"employee_id": "emp_2", "status": "out"}
+		]}`)
+	}, withClockEvents(
+		punch("emp_1", gomts.EmployeeInStatus, now.Add(-40*time.Minute)),
+		punch("emp_1", gomts.EmployeeOnBreakStatus, now.Add(-30*time.Minute)),
+		punch("emp_1", gomts.EmployeeInStatus, now.Add(-20*time.Minute)),
+	))
+
+	employees, err := client.Employees().ListWithTimeToday(context.Background())
+	assert.NoError(t, err)
+	assert.Len(t, employees, 2)
+
+	assert.Equal(t, 30, employees[0].MinutesWorkedToday)
+	assert.Equal(t, 40, employees[0].CurrentSessionMinutes)
+
+	assert.Equal(t, 0, employees[1].MinutesWorkedToday)
+	assert.Equal(t, 0, employees[1].CurrentSessionMinutes)
+}
+
+func TestEmployeesWorkMinutesToday(t *testing.T) {
+	now := time.Now()
+
+	if midnight := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location()); now.Sub(midnight) < time.Hour {
+		t.Skip("skipping as clock events would span midnight")
+	}
+
+	client := fakeServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v1.2/employees/emp_1", r.URL.Path)
+
+		fmt.Fprint(w, `{"employee": {"employee_id": "emp_1", "status": "in"}}`)
+	}, withClockEvents(
+		punch("emp_1", gomts.EmployeeInStatus, now.Add(-40*time.Minute)),
+		punch("emp_1", gomts.EmployeeOnBreakStatus, now.Add(-30*time.Minute)),
+		punch("emp_1", gomts.EmployeeInStatus, now.Add(-20*time.Minute)),
+
+		// other employees are not counted
+		punch("emp_2", gomts.EmployeeInStatus, now.Add(-50*time.Minute)),
+	))
+
+	// excludes the break, includes the current session
+	minutes, err := client.Employees().WorkMinutesToday(context.Background(), "emp_1")
+	assert.NoError(t, err)
+	assert.Equal(t, 30, minutes)
+}
+
+func TestEmployeesSetDepartment(t *testing.T) {
+	for _, keepOldAsSecondary := range []bool{true, false} {
+		t.Run(fmt.Sprintf("keepOldAsSecondary=%t", keepOldAsSecondary), func(t *testing.T) {
+			client, _ := integrationTest(t)
+
+			ctx := context.Background()
+
+			oldDept, err := client.Departments().Create(ctx, &gomts.DepartmentCreateRequest{
+				Name: testResourceName("old"),
+			})
+			assert.NoError(t, err)
+
+			newDept, err := client.Departments().Create(ctx, &gomts.DepartmentCreateRequest{
+				Name: testResourceName("new"),
+			})
+			assert.NoError(t, err)
+
+			newEmployee, err := client.Employees().Create(ctx, &gomts.EmployeeCreateRequest{
+				Name:         testResourceName("bob ross"),
+				DepartmentID: oldDept.ID,
+			})
+			assert.NoError(t, err)
+
+			_, err = client.Employees().SetDepartment(ctx, newEmployee.ID, newDept.ID, keepOldAsSecondary)
+			assert.NoError(t, err)
+
+			employee, err := client.Employees().Get(ctx, newEmployee.ID)
+			assert.NoError(t, err)
+
+			assert.Equal(t, newDept.ID, employee.PrimaryDepartmentID)
+
+			if keepOldAsSecondary {
+				assert.Contains(t, employee.SecondaryDepartmentIDs, oldDept.ID)
+			} else {
+				assert.NotContains(t, employee.SecondaryDepartmentIDs, oldDept.ID)
+			}
+		})
+	}
+}
+
+func TestEmployeesUpdate(t *testing.T) {
+	client, _ := integrationTest(t)
+
+	ctx := context.Background()
+
+	oldDept, err := client.Departments().Create(ctx, &gomts.DepartmentCreateRequest{
+		Name: testResourceName("old"),
+	})
+	assert.NoError(t, err)
+
+	newDept, err := client.Departments().Create(ctx, &gomts.DepartmentCreateRequest{
+		Name: testResourceName("new"),
+	})
+	assert.NoError(t, err)
+
+	newEmployee, err := client.Employees().Create(ctx, &gomts.EmployeeCreateRequest{
+		Name:         testResourceName("bob ross"),
+		Title:        "Junior Artist",
+		DepartmentID: oldDept.ID,
+	})
+	assert.NoError(t, err)
+
+	name := testResourceName("robert ross")
+	title := "Senior Artist"
+	convert := true
+
+	_, err = client.Employees().Update(ctx, newEmployee.ID, &gomts.EmployeeUpdateRequest{
+		Name:                     &name,
+		Title:                    &title,
+		DepartmentID:             &newDept.ID,
+		ConvertPrimaryDepartment: &convert,
+	})
+	assert.NoError(t, err)
+
+	employee, err := client.Employees().Get(ctx, newEmployee.ID)
+	assert.NoError(t, err)
+
+	assert.Equal(t, name, employee.Name)
+	assert.Equal(t, title, employee.Title)
+	assert.Equal(t, newDept.ID, employee.PrimaryDepartmentID)
+	assert.Contains(t, employee.SecondaryDepartmentIDs, oldDept.ID)
+}
+
+func TestEmployeesDelete(t *testing.T) {
+	client, _ := integrationTest(t)
+
+	ctx := context.Background()
+
+	dept, err := client.Departments().Create(ctx, &gomts.DepartmentCreateRequest{
+		Name: testResourceName("something"),
+	})
+	assert.NoError(t, err)
+
+	newEmployee, err := client.Employees().Create(ctx, &gomts.EmployeeCreateRequest{
+		Name:         testResourceName("bob ross"),
+		DepartmentID: dept.ID,
+	})
+	assert.NoError(t, err)
+
+	result, err := client.Employees().Delete(ctx, newEmployee.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, newEmployee.ID, result.ID)
+
+	_, err = client.Employees().Get(ctx, newEmployee.ID)
+
+	var mtsErr *gomts.Error
+	assert.ErrorAs(t, err, &mtsErr)
+	assert.Equal(t, http.StatusNotFound, mtsErr.ErrorCode)
+}
+
+func TestEmployeesUpdateChangeLog(t *testing.T) {
+	var changeLog bytes.Buffer
+
+	client := fakeServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			fmt.Fprint(w, `{"employee": {"employee_id": "emp_1", "name": "bob", "title": "painter", "pin": "1234"}}`)
+		case http.MethodPut:
+			fmt.Fprint(w, `{"employee": {"employee_id": "emp_1", "name": "bob", "title": "host", "pin": "5678"}}`)
+		}
+	}, func(conf *gomts.Config) {
+		conf.EmployeeChangeLog = &changeLog
+	})
+
+	title := "host"
+	pin := "5678"
+
+	_, err := client.Employees().Update(context.Background(), "emp_1", &gomts.EmployeeUpdateRequest{
+		Title: &title,
+		PIN:   &pin,
+	})
+	assert.NoError(t, err)
+
+	assert.NotContains(t, changeLog.String(), "1234")
+	assert.NotContains(t, changeLog.String(), "5678")
+
+	var change gomts.EmployeeChange
+	assert.NoError(t, json.Unmarshal(changeLog.Bytes(), &change))
+
+	assert.Equal(t, "emp_1", change.EmployeeID)
+	assert.Equal(t, map[string]gomts.EmployeeFieldChange{
+		"title": {Old: "painter", New: "host"},
+		"pin":   {Old: "REDACTED", New: "REDACTED"},
+	}, change.Changes)
+}
+
+func TestEmployeesGetAndLock(t *testing.T) {
+	client := fakeServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			w.Header().Set("ETag", `"v1"`)
+			fmt.Fprint(w, `{"employee": {"employee_id": "emp_1", "name": "bob"}}`)
+
+		case http.MethodPut:
+			if r.Header.Get("If-Match") != `"v1"` {
+				w.WriteHeader(http.StatusPreconditionFailed)
+				fmt.Fprint(w, `{"error": {"error_code": 412, "error_text": "precondition failed"}}`)
+				return
+			}
+
+			fmt.Fprint(w, `{"employee": {"employee_id": "emp_1", "name": "bob ross"}}`)
+		}
+	})
+
+	ctx := context.Background()
+
+	employee, etag, err := client.Employees().GetAndLock(ctx, "emp_1")
+	assert.NoError(t, err)
+	assert.Equal(t, "emp_1", employee.ID)
+	assert.Equal(t, `"v1"`, etag)
+
+	name := "bob ross"
+
+	_, err = client.Employees().Update(ctx, "emp_1", &gomts.EmployeeUpdateRequest{Name: &name, IfMatch: etag})
+	assert.NoError(t, err)
+
+	_, err = client.Employees().Update(ctx, "emp_1", &gomts.EmployeeUpdateRequest{Name: &name, IfMatch: `"v0"`})
+	assert.ErrorIs(t, err, gomts.ErrConflict)
+}
+
+func TestEmployeesListPage(t *testing.T) {
+	var rawQuery string
+
+	client := fakeServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		rawQuery = r.URL.RawQuery
+
+		// server is not paginating
+		fmt.Fprint(w, `{"employees": [
+			{"employee_id": "emp_1"},
+			{"employee_id": "emp_2"},
+			{"employee_id": "emp_3"}
+		]}`)
+	})
+
+	ctx := context.Background()
+
+	page, err := client.Employees().ListPage(ctx, 2, 2)
+	assert.NoError(t, err)
+
+	assert.Equal(t, "page=2&per_page=2", rawQuery)
+	assert.Equal(t, []gomts.Employee{{ID: "emp_3"}}, page.Employees)
+	assert.Equal(t, 3, page.Total)
+	assert.Equal(t, 2, page.TotalPages)
+
+	page, err = client.Employees().ListPage(ctx, 3, 2)
+	assert.NoError(t, err)
+	assert.Empty(t, page.Employees)
+
+	_, err = client.Employees().ListPage(ctx, 0, 2)
+
+	var validationErr *gomts.ValidationError
+	assert.ErrorAs(t, err, &validationErr)
+	assert.Equal(t, "page", validationErr.Field)
+}
+
+func TestEmployeesListPageServerPaginated(t *testing.T) {
+	var rawQuery string
+
+	client := fakeServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		rawQuery = r.URL.RawQuery
+
+		// server is paginating, returning only the requested page
+		fmt.Fprint(w, `{
+			"meta": {"total_count": 5, "page": 2, "per_page": 2},
+			"employees": [
+				{"employee_id": "emp_3"},
+				{"employee_id": "emp_4"}
+			]
+		}`)
+	})
+
+	page, err := client.Employees().ListPage(context.Background(), 2, 2)
+	assert.NoError(t, err)
+
+	assert.Equal(t, "page=2&per_page=2", rawQuery)
+
+	// the page is returned as is rather than sliced again
+	assert.Equal(t, []gomts.Employee{{ID: "emp_3"}, {ID: "emp_4"}}, page.Employees)
+	assert.Equal(t, 2, page.Page)
+	assert.Equal(t, 2, page.PerPage)
+	assert.Equal(t, 5, page.Total)
+	assert.Equal(t, 3, page.TotalPages)
+}
+
+func TestEmployeesListNotWorkedSince(t *testing.T) {
+	since := time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC)
+
+	client := fakeServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v1.2/employees", r.URL.Path)
+
+		fmt.Fprint(w, `{"employees": [
+			{"employee_id": "emp_1", "status": "out"},
+			{"employee_id": "emp_2", "status": "out"},
+			{"employee_id": "emp_3", "status": "out"},
+			{"employee_id": "emp_4", "status": "in"}
+		]}`)
+	}, withClockEvents(
+		punch("emp_1", gomts.EmployeeInStatus, time.Date(2024, time.February, 1, 9, 0, 0, 0, time.UTC)),
+		punch("emp_1", gomts.EmployeeOutStatus, time.Date(2024, time.February, 1, 17, 0, 0, 0, time.UTC)),
+		punch("emp_2", gomts.EmployeeInStatus, time.Date(2024, time.March, 2, 9, 0, 0, 0, time.UTC)),
+		punch("emp_2", gomts.EmployeeOutStatus, time.Date(2024, time.March, 2, 17, 0, 0, 0, time.UTC)),
+		punch("emp_4", gomts.EmployeeInStatus, time.Date(2024, time.January, 1, 9, 0, 0, 0, time.UTC)),
+	))
+
+	employees, err := client.Employees().ListNotWorkedSince(context.Background(), since)
+	assert.NoError(t, err)
+
+	assert.Equal(t, []gomts.Employee{
+		{ID: "emp_3", Status: gomts.EmployeeOutStatus},
+		{ID: "emp_1", Status: gomts.EmployeeOutStatus},
+	}, employees)
+}
+
+func TestEmployeesGetWithRelated(t *testing.T) {
+	var (
+		mtx   sync.Mutex
+		paths []string
+	)
+
+	client := fakeServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		mtx.Lock()
+		paths = append(paths, r.URL.Path)
+		mtx.Unlock()
+
+		switch r.URL.Path {
+		case "/v1.2/employees/emp_1":
+			fmt.Fprint(w, `{"employee": {"employee_id": "emp_1", "status": "out", "primary_department_id": "dep_1"}}`)
+		case "/v1.2/employees/emp_2":
+			fmt.Fprint(w, `{"employee": {"employee_id": "emp_2", "status": "out"}}`)
+		case "/v1.2/departments/dep_1":
+			fmt.Fprint(w, `{"department": {"department_id": "dep_1", "name": "painters"}}`)
+		case "/v1.2/departments/":
+			fmt.Fprint(w, `{"departments": []}`)
+		}
+	}, withClockEvents())
+
+	ctx := context.Background()
+
+	employee, err := client.Employees().GetWithRelated(ctx, "emp_1")
+	assert.NoError(t, err)
+	assert.Equal(t, "emp_1", employee.ID)
+	assert.Nil(t, employee.MinutesToday)
+	assert.Nil(t, employee.Department)
+	assert.Equal(t, []string{"/v1.2/employees/emp_1"}, paths)
+
+	employee, err = client.Employees().GetWithRelated(ctx, "emp_1", gomts.IncludeTimeToday, gomts.IncludeDepartment)
+	assert.NoError(t, err)
+	if assert.NotNil(t, employee.MinutesToday) {
+		assert.Equal(t, 0, *employee.MinutesToday)
+	}
+	assert.Equal(t, &gomts.Department{ID: "dep_1", Name: "painters"}, employee.Department)
+
+	// without a primary department, the department list must not be fetched
+	mtx.Lock()
+	paths = nil
+	mtx.Unlock()
+
+	employee, err = client.Employees().GetWithRelated(ctx, "emp_2", gomts.IncludeDepartment)
+	assert.NoError(t, err)
+	assert.Nil(t, employee.Department)
+	assert.Equal(t, []string{"/v1.2/employees/emp_2"}, paths)
+
+	_, err = client.Employees().GetWithRelated(ctx, "emp_1", gomts.IncludeShift)
+	assert.ErrorIs(t, err, gomts.ErrNotImplemented)
+
+	_, err = client.Employees().GetWithRelated(ctx, "emp_1", "manager")
+
+	var validationErr *gomts.ValidationError
+	assert.ErrorAs(t, err, &validationErr)
+}
+
+func TestEmployeesBatchAssignDepartment(t *testing.T) {
+	var (
+		mtx               sync.Mutex
+		inFlight, maxSeen int
+	)
+
+	client := fakeServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		mtx.Lock()
+		inFlight++
+		maxSeen = max(maxSeen, inFlight)
+		mtx.Unlock()
+
+		defer func() {
+			mtx.Lock()
+			inFlight--
+			mtx.Unlock()
+		}()
+
+		time.Sleep(10 * time.Millisecond)
+
+		if r.URL.Path == "/v1.2/employees/emp_3" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		fmt.Fprint(w, `{"employee": {}}`)
+	}, func(conf *gomts.Config) {
+		conf.BatchConcurrency = 2
+	})
+
+	ids := []string{"emp_1", "emp_2", "emp_3", "emp_4", "emp_5"}
+
+	results, err := client.Employees().BatchAssignDepartment(context.Background(), ids, "dep_1", false)
+	assert.NoError(t, err)
+
+	assert.Equal(t, 2, maxSeen)
+
+	if assert.Len(t, results, len(ids)) {
+		for i, result := range results {
+			assert.Equal(t, ids[i], result.EmployeeID)
+			assert.Equal(t, result.EmployeeID != "emp_3", result.Success)
+		}
+
+		assert.Error(t, results[2].Err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	results, err = client.Employees().BatchAssignDepartment(ctx, ids, "dep_1", false)
+	assert.ErrorIs(t, err, context.Canceled)
+
+	for _, result := range results {
+		assert.False(t, result.Success)
+		assert.ErrorIs(t, result.Err, context.Canceled)
+	}
+}
+
+func TestEmployeesIsFullTime(t *testing.T) {
+	now := time.Now()
+
+	// 9am on the Monday of last week
+	monday := time.Date(now.Year(), now.Month(), now.Day()-(int(now.Weekday())+6)%7-7, 9, 0, 0, 0, now.Location())
+
+	client := fakeServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v1.2/employees/emp_1", r.URL.Path)
+
+		fmt.Fprint(w, `{"employee": {"employee_id": "emp_1", "status": "out"}}`)
+	}, withClockEvents(
+		punch("emp_1", gomts.EmployeeInStatus, monday),
+		punch("emp_1", gomts.EmployeeOutStatus, monday.Add(30*time.Hour)),
+	))
+
+	ctx := context.Background()
+
+	fullTime, err := client.Employees().IsFullTime(ctx, "emp_1", 1)
+	assert.NoError(t, err)
+	assert.True(t, fullTime)
+
+	fullTime, err = client.Employees().IsFullTime(ctx, "emp_1", 2)
+	assert.NoError(t, err)
+	assert.False(t, fullTime)
+
+	_, err = client.Employees().IsFullTime(ctx, "emp_1", 0)
+
+	var validationErr *gomts.ValidationError
+	assert.ErrorAs(t, err, &validationErr)
+}
+
+func TestEmployeesIsNearHoursCap(t *testing.T) {
+	now := time.Now()
+	monday := time.Date(now.Year(), now.Month(), now.Day()-(int(now.Weekday())+6)%7, 0, 0, 0, 0, now.Location())
+
+	if now.Sub(monday) < time.Hour {
+		t.Skip("skipping as clock events would span the start of the week")
+	}
+
+	client := fakeServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/v1.2/employees/emp_uncapped":
+			fmt.Fprint(w, `{"employee": {"employee_id": "emp_uncapped", "status": "in"}}`)
+
+		case "/v1.2/employees/emp_zero":
+			fmt.Fprint(w, `{"employee": {"employee_id": "emp_zero", "status": "in", "max_weekly_minutes": 0}}`)
+
+		case "/v1.2/employees/emp_capped":
+			fmt.Fprint(w, `{"employee": {"employee_id": "emp_capped", "status": "in", "max_weekly_minutes": 100}}`)
+		}
+	}, withClockEvents(
+		// each clocked in for an hour this week
+		punch("emp_uncapped", gomts.EmployeeInStatus, now.Add(-time.Hour)),
+		punch("emp_zero", gomts.EmployeeInStatus, now.Add(-time.Hour)),
+		punch("emp_capped", gomts.EmployeeInStatus, now.Add(-time.Hour)),
+	))
+
+	for _, tc := range []struct {
+		employeeID string
+		threshold  float64
+		want       bool
+	}{
+		{employeeID: "emp_uncapped", threshold: 0, want: false},
+		{employeeID: "emp_zero", threshold: 0, want: false},
+		{employeeID: "emp_capped", threshold: 50, want: true},
+		{employeeID: "emp_capped", threshold: 59, want: true},
+		{employeeID: "emp_capped", threshold: 61, want: false},
+		{employeeID: "emp_capped", threshold: 90, want: false},
+	} {
+		near, err := client.Employees().IsNearHoursCap(context.Background(), tc.employeeID, tc.threshold)
+		assert.NoError(t, err)
+		assert.Equal(t, tc.want, near, "%s at %v%%", tc.employeeID, tc.threshold)
+	}
+}
+
+func TestEmployeesGetWorkdayMinutes(t *testing.T) {
+	date := time.Date(2024, time.March, 4, 9, 0, 0, 0, time.Local)
+
+	client := fakeServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v1.2/employees/emp_1", r.URL.Path)
+
+		fmt.Fprint(w, `{"employee": {"employee_id": "emp_1", "status": "out"}}`)
+	}, withClockEvents(
+		punch("emp_1", gomts.EmployeeInStatus, date),
+		punch("emp_1", gomts.EmployeeOnBreakStatus, date.Add(4*time.Hour)),
+		punch("emp_1", gomts.EmployeeInStatus, date.Add(4*time.Hour+30*time.Minute)),
+		punch("emp_1", gomts.EmployeeOutStatus, date.Add(8*time.Hour+30*time.Minute)),
+	))
+
+	ctx := context.Background()
+
+	minutes, err := client.Employees().GetWorkdayMinutes(ctx, "emp_1", date)
+	assert.NoError(t, err)
+	assert.Equal(t, 8*60, minutes)
+
+	minutes, err = client.Employees().GetWorkdayBreakMinutes(ctx, "emp_1", date)
+	assert.NoError(t, err)
+	assert.Equal(t, 30, minutes)
+}
+
+func TestEmployeesListWithHoursThisWeek(t *testing.T) {
+	tz := time.FixedZone("UTC+14", 14*60*60)
+
+	now := time.Now().In(tz)
+	monday := time.Date(now.Year(), now.Month(), now.Day()-(int(now.Weekday())+6)%7, 0, 0, 0, 0, tz)
+
+	if now.Sub(monday) < time.Hour {
+		t.Skip("skipping as clock events would span the start of the week")
+	}
+
+	clockEvents := &recordingClockEvents{
+		ClockEventClient: gomts.NewClockEventSource([]gomts.ClockEvent{
+			punch("emp_1", gomts.EmployeeInStatus, now.Add(-time.Hour)),
+		}),
+	}
+
+	client := fakeServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"employees": [{"employee_id": "emp_1", "status": "in"}]}`)
+	}, func(conf *gomts.Config) {
+		conf.Timezone = tz
+		conf.ClockEventSource = clockEvents
+	})
+
+	employees, err := client.Employees().ListWithHoursThisWeek(context.Background())
+	assert.NoError(t, err)
+
+	if assert.Len(t, clockEvents.requests, 1) {
+		assert.True(t, monday.Equal(clockEvents.requests[0].Start))
+	}
+
+	if assert.Len(t, employees, 1) {
+		assert.Equal(t, 60, employees[0].MinutesThisWeek)
+	}
+}
+
+func TestEmployeesSetAndPatchCustomFields(t *testing.T) {
+	var (
+		body    map[string]any
+		ifMatch string
+	)
+
+	client := fakeServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			w.Header().Set("ETag", `"v1"`)
+			fmt.Fprint(w, `{"employee": {"employee_id": "emp_1", "custom_fields": {"phone": "555-0100", "team": "blue"}}}`)
+
+		case http.MethodPut:
+			ifMatch = r.Header.Get("If-Match")
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			fmt.Fprint(w, `{"employee": {"employee_id": "emp_1"}}`)
+		}
+	})
+
+	ctx := context.Background()
+
+	_, err := client.Employees().SetCustomFields(ctx, "emp_1", map[string]string{"phone": "555-0199"})
+	assert.NoError(t, err)
+
+	assert.Equal(t, `"v1"`, ifMatch)
+	assert.Equal(t, map[string]any{"phone": "555-0199", "team": nil}, body["custom_fields"])
+
+	_, err = client.Employees().PatchCustomFields(ctx, "emp_1", map[string]string{"email": "bob@example.com"})
+	assert.NoError(t, err)
+
+	assert.Equal(t, map[string]any{
+		"phone": "555-0100",
+		"team":  "blue",
+		"email": "bob@example.com",
+	}, body["custom_fields"])
+}
+
+func TestEmployeesListByHourlyRateRange(t *testing.T) {
+	client := fakeServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"employees": [
+			{"employee_id": "emp_1", "hourly_rate": 15},
+			{"employee_id": "emp_2", "custom_fields": {"hourly_rate": "18.5"}},
+			{"employee_id": "emp_3", "hourly_rate": 25},
+			{"employee_id": "emp_4"}
+		]}`)
+	})
+
+	ctx := context.Background()
+
+	employees, err := client.Employees().ListByHourlyRateRange(ctx, 15, 20)
+	assert.NoError(t, err)
+
+	var ids []string
+	for _, employee := range employees {
+		ids = append(ids, employee.ID)
+	}
+
+	assert.Equal(t, []string{"emp_1", "emp_2"}, ids)
+
+	_, err = client.Employees().ListByHourlyRateRange(ctx, 20, 15)
+
+	var validationErr *gomts.ValidationError
+	assert.ErrorAs(t, err, &validationErr)
+}
+
+func TestEmployeesListByDepartmentStatus(t *testing.T) {
+	client := fakeServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"employees": [
+			{"employee_id": "emp_1", "status": "in", "current_department_id": "dep_1"},
+			{"employee_id": "emp_2", "status": "in", "current_department_id": "dep_2"},
+			{"employee_id": "emp_3", "status": "in", "current_department_id": "dep_1"},
+			{"employee_id": "emp_4", "status": "out", "current_department_id": "dep_3"},
+			{"employee_id": "emp_5", "status": "break", "current_department_id": "dep_2"},
+			{"employee_id": "emp_6", "status": "unknown", "current_department_id": "dep_4"}
+		]}`)
+	})
+
+	byDepartment, err := client.Employees().ListByDepartmentStatus(context.Background())
+	assert.NoError(t, err)
+
+	ids := make(map[string][]string)
+	for departmentID, employees := range byDepartment {
+		for _, employee := range employees {
+			ids[departmentID] = append(ids[departmentID], employee.ID)
+		}
+	}
+
+	assert.Equal(t, map[string][]string{
+		"dep_1": {"emp_1", "emp_3"},
+		"dep_2": {"emp_2", "emp_5"},
+	}, ids)
+}
+
+func TestEmployeesWorkloadComparison(t *testing.T) {
+	monday := time.Date(2024, time.March, 4, 0, 0, 0, 0, time.UTC)
+
+	at := func(hour int) time.Time {
+		return monday.Add(time.Duration(hour) * time.Hour)
+	}
+
+	client := fakeServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v1.2/employees", r.URL.Path)
+
+		fmt.Fprint(w, `{"employees": [
+			{"employee_id": "emp_1", "status": "out", "primary_department_id": "dep_1"},
+			{"employee_id": "emp_2", "status": "out", "primary_department_id": "dep_1"},
+			{"employee_id": "emp_3", "status": "out", "primary_department_id": "dep_2"}
+		]}`)
+	}, withClockEvents(
+		punch("emp_1", gomts.EmployeeInStatus, at(9)),
+		punch("emp_1", gomts.EmployeeOutStatus, at(11)),
+		punch("emp_2", gomts.EmployeeInStatus, at(9)),
+		punch("emp_2", gomts.EmployeeOutStatus, at(13)),
+		punch("emp_3", gomts.EmployeeInStatus, at(9)),
+		punch("emp_3", gomts.EmployeeOutStatus, at(17)),
+	))
+
+	workloads, err := client.Employees().WorkloadComparison(context.Background(), "dep_1", gomts.DateRange{
+		Start: monday,
+		End:   monday.AddDate(0, 0, 7),
+	})
+	assert.NoError(t, err)
+
+	if assert.Len(t, workloads, 2) {
+		assert.Equal(t, "emp_2", workloads[0].ID)
+		assert.Equal(t, 240, workloads[0].TotalMinutes)
+		assert.Equal(t, 100.0, workloads[0].PercentOfMax)
+
+		assert.Equal(t, "emp_1", workloads[1].ID)
+		assert.Equal(t, 120, workloads[1].TotalMinutes)
+		assert.Equal(t, 50.0, workloads[1].PercentOfMax)
+	}
+}
+
+func TestEmployeesGetByAnyID(t *testing.T) {
+	var (
+		mtx   sync.Mutex
+		paths []string
+	)
+
+	client := fakeServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		mtx.Lock()
+		paths = append(paths, r.URL.EscapedPath())
+		mtx.Unlock()
+
+		switch {
+		case r.URL.Path == "/v1.2/employees":
+			fmt.Fprint(w, `{"employees": [
+				{"employee_id": "emp_1", "custom_employee_id": "1234", "pin": "5678", "card_number": "c-1"},
+				{"employee_id": "emp_2", "custom_employee_id": "5678", "pin": "1234"},
+				{"employee_id": "emp_3", "custom_employee_id": "x?y", "card_number": "c/3"}
+			]}`)
+
+		case r.URL.Path == "/v1.2/employees/emp_1":
+			fmt.Fprint(w, `{"employee": {"employee_id": "emp_1"}}`)
+
+		case strings.Contains(r.URL.EscapedPath(), "%3F"):
+			w.WriteHeader(http.StatusBadRequest)
+
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	ctx := context.Background()
+
+	for id, want := range map[string]string{
+		"emp_1": "emp_1",
+		"1234":  "emp_1", // custom ID takes precedence over emp_2's PIN
+		"5678":  "emp_2",
+		"c-1":   "emp_1",
+		"x?y":   "emp_3", // rejected as malformed by get
+		"c/3":   "emp_3",
+	} {
+		employee, err := client.Employees().GetByAnyID(ctx, id)
+		if assert.NoError(t, err, id) {
+			assert.Equal(t, want, employee.ID, id)
+		}
+	}
+
+	// identifiers are escaped rather than changing the path or query
+	assert.Contains(t, paths, "/v1.2/employees/x%3Fy")
+	assert.Contains(t, paths, "/v1.2/employees/c%2F3")
+
+	_, err := client.Employees().GetByAnyID(ctx, "missing")
+
+	var mtsErr *gomts.Error
+	assert.ErrorAs(t, err, &mtsErr)
+	assert.Equal(t, http.StatusNotFound, mtsErr.ErrorCode)
+}
+
+func TestEmployeesImportFromJSON(t *testing.T) {
+	var (
+		mtx   sync.Mutex
+		names []string
+	)
+
+	client := fakeServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.NoError(t, r.ParseForm())
+
+		mtx.Lock()
+		names = append(names, r.PostForm.Get("name"))
+		mtx.Unlock()
+
+		if r.PostForm.Get("department_id") == "dep_missing" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		fmt.Fprint(w, `{"employee": {}}`)
+	})
+
+	ctx := context.Background()
+
+	imported, errs := client.Employees().ImportFromJSON(ctx, strings.NewReader(`[
+		{"name": "bob ross", "department_id": "dep_1", "custom_fields": {"team": "blue"}},
+		{"name": "steve ross", "department_id": "dep_missing"},
+		{"name": "annette kowalski", "department_id": "dep_1", "generate_pin": true}
+	]`))
+
+	assert.Equal(t, 2, imported)
+	assert.ElementsMatch(t, []string{"bob ross", "steve ross", "annette kowalski"}, names)
+
+	if assert.Len(t, errs, 1) {
+		assert.ErrorContains(t, errs[0], "employee 1")
+	}
+
+	imported, errs = client.Employees().ImportFromJSON(ctx, strings.NewReader(`{"name": "bob ross"}`))
+	assert.Zero(t, imported)
+	assert.Len(t, errs, 1)
+}
+
+func TestEmployeesImportFromJSONRoundTrip(t *testing.T) {
+	client, _ := integrationTest(t)
+
+	ctx := context.Background()
+
+	dept, err := client.Departments().Create(ctx, &gomts.DepartmentCreateRequest{
+		Name: testResourceName("something"),
+	})
+	assert.NoError(t, err)
+
+	original, err := client.Employees().Create(ctx, &gomts.EmployeeCreateRequest{
+		Name:         testResourceName("bob ross"),
+		DepartmentID: dept.ID,
+		Title:        "Senior Artist",
+	})
+	assert.NoError(t, err)
+
+	b, err := json.Marshal([]gomts.EmployeeCreateRequest{{
+		Name:         original.Name + " copy",
+		DepartmentID: original.PrimaryDepartmentID,
+		Title:        original.Title,
+	}})
+	assert.NoError(t, err)
+
+	imported, errs := client.Employees().ImportFromJSON(ctx, bytes.NewReader(b))
+	assert.Empty(t, errs)
+	assert.Equal(t, 1, imported)
+
+	employees, err := client.Employees().ListByDepartment(ctx, dept.ID)
+	assert.NoError(t, err)
+
+	var names []string
+	for _, employee := range employees {
+		names = append(names, employee.Name)
+	}
+
+	assert.ElementsMatch(t, []string{original.Name, original.Name + " copy"}, names)
+}
+
+func TestEmployeesSetEmail(t *testing.T) {
+	var body map[string]any
+
+	client := fakeServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			fmt.Fprint(w, `{"employee": {"employee_id": "emp_1", "custom_fields": {"team": "blue"}}}`)
+
+		case http.MethodPut:
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			fmt.Fprint(w, `{"employee": {"employee_id": "emp_1"}}`)
+		}
+	})
+
+	ctx := context.Background()
+
+	_, err := client.Employees().SetEmail(ctx, "emp_1", "bob@example.com")
+	assert.NoError(t, err)
+
+	assert.Equal(t, map[string]any{"email": "bob@example.com", "team": "blue"}, body["custom_fields"])
+
+	for _, email := range []string{"", "bob", "bob@example", "bob ross@example.com", "bob@@example.com"} {
+		_, err = client.Employees().SetEmail(ctx, "emp_1", email)
+
+		var validationErr *gomts.ValidationError
+		if assert.ErrorAs(t, err, &validationErr, email) {
+			assert.Equal(t, "email", validationErr.Field)
+		}
+	}
+}
+
+func TestEmployeesCreateGeneratePIN(t *testing.T) {
+	var sentPIN string
+
+	client := fakeServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.NoError(t, r.ParseForm())
+		sentPIN = r.PostForm.Get("pin")
+
+		// the PIN is not echoed back
+		fmt.Fprint(w, `{"employee": {"employee_id": "emp_1"}}`)
+	}, func(conf *gomts.Config) {
+		conf.PINGenerator = func() (string, error) {
+			return "4321", nil
+		}
+	})
+
+	ctx := context.Background()
+
+	employee, err := client.Employees().Create(ctx, &gomts.EmployeeCreateRequest{
+		Name:        "bob ross",
+		GeneratePIN: true,
+	})
+	assert.NoError(t, err)
+
+	assert.Equal(t, "4321", sentPIN)
+	assert.Equal(t, "4321", employee.PIN)
+
+	// an explicit PIN is not replaced
+	employee, err = client.Employees().Create(ctx, &gomts.EmployeeCreateRequest{
+		Name:        "bob ross",
+		PIN:         "1111",
+		GeneratePIN: true,
+	})
+	assert.NoError(t, err)
+
+	assert.Equal(t, "1111", sentPIN)
+	assert.Empty(t, employee.PIN)
+
+	// the default generator generates 4 digits
+	client = fakeServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.NoError(t, r.ParseForm())
+		sentPIN = r.PostForm.Get("pin")
+
+		fmt.Fprint(w, `{"employee": {"employee_id": "emp_1"}}`)
+	})
+
+	employee, err = client.Employees().Create(ctx, &gomts.EmployeeCreateRequest{
+		Name:        "bob ross",
+		GeneratePIN: true,
+	})
+	assert.NoError(t, err)
+
+	assert.Regexp(t, `^\d{4}$`, sentPIN)
+	assert.Equal(t, sentPIN, employee.PIN)
+}
+
+func TestEmployeesAssignOvertimePolicy(t *testing.T) {
+	var (
+		method, path string
+		body         map[string]any
+	)
+
+	client := fakeServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		method, path = r.Method, r.URL.Path
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+
+		fmt.Fprint(w, `{"employee": {"employee_id": "emp_1", "overtime_policy_id": "otp_1"}}`)
+	})
+
+	employee, err := client.Employees().AssignOvertimePolicy(context.Background(), "emp_1", "otp_1")
+	assert.NoError(t, err)
+
+	assert.Equal(t, http.MethodPut, method)
+	assert.Equal(t, "/v1.2/employees/emp_1", path)
+	assert.Equal(t, map[string]any{"overtime_policy_id": "otp_1"}, body)
+	assert.Equal(t, "otp_1", employee.OvertimePolicyID)
+}
+
+func TestEmployeesValidatePINUniqueness(t *testing.T) {
+	var created bool
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost {
+			created = true
+			fmt.Fprint(w, `{"employee": {"employee_id": "emp_2"}}`)
+			return
+		}
+
+		fmt.Fprint(w, `{"employees": [{"employee_id": "emp_1", "pin": "1234"}]}`)
+	}
+
+	client := fakeServerClient(t, handler, func(conf *gomts.Config) {
+		conf.ValidatePINUniqueness = true
+	})
+
+	ctx := context.Background()
+
+	unique, err := client.Employees().IsPINUnique(ctx, "1234")
+	assert.NoError(t, err)
+	assert.False(t, unique)
+
+	unique, err = client.Employees().IsPINUnique(ctx, "5678")
+	assert.NoError(t, err)
+	assert.True(t, unique)
+
+	_, err = client.Employees().Create(ctx, &gomts.EmployeeCreateRequest{Name: "bob ross", PIN: "1234"})
+
+	var validationErr *gomts.ValidationError
+	if assert.ErrorAs(t, err, &validationErr) {
+		assert.Equal(t, "pin", validationErr.Field)
+	}
+
+	assert.False(t, created)
+
+	employee, err := client.Employees().Create(ctx, &gomts.EmployeeCreateRequest{Name: "bob ross", PIN: "5678"})
+	assert.NoError(t, err)
+	assert.Equal(t, "emp_2", employee.ID)
+	assert.True(t, created)
+
+	// duplicate PINs are not checked unless enabled
+	created = false
+
+	_, err = fakeServerClient(t, handler).Employees().Create(ctx, &gomts.EmployeeCreateRequest{Name: "bob ross", PIN: "1234"})
+	assert.NoError(t, err)
+	assert.True(t, created)
+}
+
+func TestEmployeesCount(t *testing.T) {
+	client := fakeServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v1.2/employees", r.URL.Path)
+
+		fmt.Fprint(w, `{"employees": [
+			{"employee_id": "emp_1"},
+			{"employee_id": "emp_2"},
+			{"employee_id": "emp_3"}
+		]}`)
+	})
+
+	count, err := client.Employees().Count(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, 3, count)
+}
+
+func TestEmployeesSetPIN(t *testing.T) {
+	var requests []string
+
+	client := fakeServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		assert.NoError(t, err)
+
+		requests = append(requests, r.Method+" "+r.URL.Path)
+
+		assert.JSONEq(t, `{"pin": "1234"}`, string(body))
+
+		fmt.Fprint(w, `{"employee": {"employee_id": "emp_1", "pin": "1234"}}`)
+	})
+
+	ctx := context.Background()
+
+	for _, pin := range []string{"12a4", "123", "12345", ""} {
+		_, err := client.Employees().SetPIN(ctx, "emp_1", pin)
+
+		var validationErr *gomts.ValidationError
+		if assert.ErrorAs(t, err, &validationErr, pin) {
+			assert.Equal(t, "pin", validationErr.Field)
+		}
+	}
+
+	// invalid PINs are rejected before any request is made
+	assert.Empty(t, requests)
+
+	employee, err := client.Employees().SetPIN(ctx, "emp_1", "1234")
+	assert.NoError(t, err)
+	assert.Equal(t, "1234", employee.PIN)
+	assert.Equal(t, []string{"PUT /v1.2/employees/emp_1"}, requests)
+}
+
+func TestEmployeeStatusHelpers(t *testing.T) {
+	for _, tc := range []struct {
+		status                            gomts.EmployeeStatus
+		isOnBreak, isWorking, isClockedIn bool
+	}{
+		{status: gomts.EmployeeInStatus, isWorking: true, isClockedIn: true},
+		{status: gomts.EmployeeOnBreakStatus, isOnBreak: true, isClockedIn: true},
+		{status: gomts.EmployeeOutStatus},
+		{status: ""},
+		{status: "unknown"},
+	} {
+		t.Run(string(tc.status), func(t *testing.T) {
+			employee := &gomts.Employee{Status: tc.status}
+
+			assert.Equal(t, tc.isOnBreak, employee.IsOnBreak())
+			assert.Equal(t, tc.isWorking, employee.IsWorking())
+			assert.Equal(t, tc.isClockedIn, tc.status.IsClockedIn())
+		})
+	}
+}
+
+func TestEmployeesListSecondaryDepartments(t *testing.T) {
+	var paths []string
+
+	client := fakeServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		paths = append(paths, r.URL.Path)
+
+		switch r.URL.Path {
+		case "/v1.2/employees/emp_1":
+			// only the IDs of the secondary departments are returned
+			fmt.Fprint(w, `{"employee": {"employee_id": "emp_1", "secondary_department_ids": ["dep_3", "dep_2", "dep_9"]}}`)
+
+		case "/v1.2/employees/emp_2":
+			fmt.Fprint(w, `{"employee": {
+				"employee_id": "emp_2",
+				"secondary_department_ids": ["dep_2"],
+				"secondary_departments": [{"department_id": "dep_2", "name": "framers"}]
+			}}`)
+
+		case "/v1.2/departments":
+			fmt.Fprint(w, `{"departments": [
+				{"department_id": "dep_1", "name": "painters"},
+				{"department_id": "dep_2", "name": "framers"},
+				{"department_id": "dep_3", "name": "sweepers"}
+			]}`)
+		}
+	})
+
+	ctx := context.Background()
+
+	departments, err := client.Employees().ListSecondaryDepartments(ctx, "emp_1")
+	assert.NoError(t, err)
+
+	// resolved in the order of the IDs, skipping unknown departments
+	assert.Equal(t, []gomts.Department{
+		{ID: "dep_3", Name: "sweepers"},
+		{ID: "dep_2", Name: "framers"},
+	}, departmentIDsAndNames(departments))
+	assert.Equal(t, []string{"/v1.2/employees/emp_1", "/v1.2/departments"}, paths)
+
+	paths = nil
+
+	departments, err = client.Employees().ListSecondaryDepartments(ctx, "emp_2")
+	assert.NoError(t, err)
+
+	// already included, so departments are not listed
+	assert.Equal(t, []gomts.Department{{ID: "dep_2", Name: "framers"}}, departmentIDsAndNames(departments))
+	assert.Equal(t, []string{"/v1.2/employees/emp_2"}, paths)
+}
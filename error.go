@@ -1,10 +1,20 @@
 package gomts
 
 import (
+	"errors"
 	"fmt"
+	"net/http"
 	"strings"
 )
 
+var (
+	ErrNotImplemented = errors.New("not implemented")
+
+	// ErrConflict is matched by an *Error for a failed precondition, e.g. an
+	// update conditional on a stale ETag.
+	ErrConflict = errors.New("conflict")
+)
+
 // ErrorResponse represents a response body containing a service error.
 type ErrorResponse struct {
 	Error `json:"error"`
@@ -14,13 +24,42 @@ type ErrorResponse struct {
 type Error struct {
 	ErrorCode int    `json:"error_code"`
 	ErrorText string `json:"error_text"`
+
+	// CorrelationID is the ID generated by the client for the failed request.
+	// It is included in the request/response debug logs.
+	CorrelationID string `json:"-"`
 }
 
 // Error implements error.
 func (e *Error) Error() string {
+	if e.CorrelationID != "" {
+		return fmt.Sprintf("[%d] %s (correlation_id: %s)", e.ErrorCode, e.ErrorText, e.CorrelationID)
+	}
+
 	return fmt.Sprintf("[%d] %s", e.ErrorCode, e.ErrorText)
 }
 
+// Is reports whether the error matches target, allowing 412 Precondition
+// Failed errors to be matched with errors.Is(err, ErrConflict).
+func (e *Error) Is(target error) bool {
+	return target == ErrConflict && e.ErrorCode == http.StatusPreconditionFailed
+}
+
+// ValidationError represents a request that failed client-side validation.
+// It is returned before any request is made to the MyTimeStation API.
+type ValidationError struct {
+	// Field is the name of the invalid field.
+	Field string
+
+	// Reason describes why the field is invalid.
+	Reason string
+}
+
+// Error implements error.
+func (e *ValidationError) Error() string {
+	return fmt.Sprintf("invalid %s: %s", e.Field, e.Reason)
+}
+
 // ErrorList represents a list of generic errors.
 type ErrorList []error
 
@@ -30,9 +69,50 @@ func (l ErrorList) Error() string {
 
 	sb.WriteString("error:")
 
-	for _, err := range l {
-		return fmt.Sprintf(" %v ;", err)
+	for i, err := range l {
+		if i > 0 {
+			sb.WriteString(";")
+		}
+
+		fmt.Fprintf(sb, " %v", err)
 	}
 
 	return sb.String()
 }
+
+// First returns the first error in the list or nil if empty.
+func (l ErrorList) First() error {
+	if len(l) == 0 {
+		return nil
+	}
+
+	return l[0]
+}
+
+// Last returns the last error in the list or nil if empty.
+func (l ErrorList) Last() error {
+	if len(l) == 0 {
+		return nil
+	}
+
+	return l[len(l)-1]
+}
+
+// Len returns the number of errors in the list.
+func (l ErrorList) Len() int {
+	return len(l)
+}
+
+// collectEmployeeErrors returns the non-nil errors in errs as an ErrorList,
+// each prefixed with the ID of the employee at the same index in employeeIDs.
+func collectEmployeeErrors(errs []error, employeeIDs []string) ErrorList {
+	var out ErrorList
+
+	for i, err := range errs {
+		if err != nil {
+			out = append(out, fmt.Errorf("employee %q: %w", employeeIDs[i], err))
+		}
+	}
+
+	return out
+}
@@ -0,0 +1,27 @@
+package gomts_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.charbar.io/gomts"
+)
+
+func TestErrorList(t *testing.T) {
+	first := errors.New("first")
+	last := &gomts.Error{ErrorCode: 404, ErrorText: "Not Found"}
+
+	errList := gomts.ErrorList{first, last}
+
+	assert.Equal(t, "error: first; [404] Not Found", errList.Error())
+	assert.Equal(t, first, errList.First())
+	assert.Equal(t, last, errList.Last())
+	assert.Equal(t, 2, errList.Len())
+
+	var empty gomts.ErrorList
+
+	assert.Nil(t, empty.First())
+	assert.Nil(t, empty.Last())
+	assert.Equal(t, 0, empty.Len())
+}
@@ -0,0 +1,71 @@
+package gomts
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"time"
+)
+
+// Ping lists departments, the smallest authenticated request, as the
+// MyTimeStation API has no dedicated health endpoint.
+func (c *client) Ping(ctx context.Context) error {
+	_, err := httpGet[DepartmentListResponse](ctx, c, "/departments")
+	return err
+}
+
+// healthResponse is the response body written by HealthHandler.
+type healthResponse struct {
+	Status string `json:"status"`
+	Error  string `json:"error,omitempty"`
+}
+
+// HealthHandler returns an http.Handler reporting whether the MyTimeStation API
+// is reachable with client, e.g. for readiness and liveness probes. It responds
+// with 200 OK and {"status":"healthy"} if client.Ping succeeds, or with 503
+// Service Unavailable and {"status":"unhealthy","error":"..."} if it fails.
+//
+// Ping uses the request's context. The optional timeout query parameter, a
+// duration such as "2s", bounds it further; an invalid timeout responds with
+// 400 Bad Request.
+func HealthHandler(client Client) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		if raw := r.URL.Query().Get("timeout"); raw != "" {
+			timeout, err := time.ParseDuration(raw)
+			if err != nil || timeout <= 0 {
+				writeHealth(w, http.StatusBadRequest, healthResponse{
+					Status: "unhealthy",
+					Error:  "invalid timeout: " + raw,
+				})
+				return
+			}
+
+			var cancel context.CancelFunc
+			ctx, cancel = context.WithTimeout(ctx, timeout)
+			defer cancel()
+		}
+
+		if err := client.Ping(ctx); err != nil {
+			writeHealth(w, http.StatusServiceUnavailable, healthResponse{
+				Status: "unhealthy",
+				Error:  err.Error(),
+			})
+			return
+		}
+
+		writeHealth(w, http.StatusOK, healthResponse{Status: "healthy"})
+	})
+}
+
+// writeHealth writes resp as the JSON body of a response with the given
+// status code.
+func writeHealth(w http.ResponseWriter, code int, resp healthResponse) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+
+	// the status code is already written so an encoding error cannot be
+	// reported
+	_ = json.NewEncoder(w).Encode(resp)
+}
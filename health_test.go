@@ -0,0 +1,83 @@
+package gomts_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.charbar.io/gomts"
+)
+
+func TestHealthHandler(t *testing.T) {
+	healthy := func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"departments": []}`)
+	}
+
+	for name, tc := range map[string]struct {
+		handler    http.HandlerFunc
+		target     string
+		wantCode   int
+		wantStatus string
+		wantError  string
+	}{
+		"healthy": {
+			handler:    healthy,
+			target:     "/healthz",
+			wantCode:   http.StatusOK,
+			wantStatus: "healthy",
+		},
+		"unauthorized": {
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusUnauthorized)
+			},
+			target:     "/healthz",
+			wantCode:   http.StatusServiceUnavailable,
+			wantStatus: "unhealthy",
+			wantError:  "401",
+		},
+		"timeout": {
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				select {
+				case <-r.Context().Done():
+				case <-time.After(time.Second):
+				}
+			},
+			target:     "/healthz?timeout=20ms",
+			wantCode:   http.StatusServiceUnavailable,
+			wantStatus: "unhealthy",
+			wantError:  "deadline exceeded",
+		},
+		"invalid timeout": {
+			handler:    healthy,
+			target:     "/healthz?timeout=soon",
+			wantCode:   http.StatusBadRequest,
+			wantStatus: "unhealthy",
+			wantError:  "invalid timeout",
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			handler := gomts.HealthHandler(fakeServerClient(t, tc.handler))
+
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, tc.target, nil))
+
+			assert.Equal(t, tc.wantCode, rec.Code)
+			assert.Equal(t, "application/json", rec.Header().Get("Content-Type"))
+
+			var body map[string]string
+			assert.NoError(t, json.NewDecoder(rec.Body).Decode(&body))
+
+			assert.Equal(t, tc.wantStatus, body["status"])
+
+			if tc.wantError == "" {
+				assert.NotContains(t, body, "error")
+			} else {
+				assert.Contains(t, body["error"], tc.wantError)
+			}
+		})
+	}
+}
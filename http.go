@@ -10,9 +10,11 @@ import (
 	"log/slog"
 	"net/http"
 	"net/http/httputil"
+	"sync"
 
 	"github.com/google/go-querystring/query"
 	"github.com/google/uuid"
+	"golang.org/x/net/http2"
 )
 
 var (
@@ -25,6 +27,10 @@ type mtsTransport struct {
 
 	// logr is used for logging dumped requests/responses if debug is enabled.
 	logr *slog.Logger
+
+	// http2Once guards configuring http2Transport if ForceHTTP2 is enabled.
+	http2Once      *sync.Once
+	http2Transport http.RoundTripper
 }
 
 // getWrappedTransport gets the underlying http.RoundTripper that will be used
@@ -33,6 +39,11 @@ type mtsTransport struct {
 //
 // If not set, http.DefaultTransport is used.
 func (t *mtsTransport) getWrappedTransport() http.RoundTripper {
+	if t.conf.ForceHTTP2 {
+		t.http2Once.Do(t.configureHTTP2)
+		return t.http2Transport
+	}
+
 	if t.conf.Transport != nil {
 		return t.conf.Transport
 	}
@@ -40,6 +51,24 @@ func (t *mtsTransport) getWrappedTransport() http.RoundTripper {
 	return http.DefaultTransport
 }
 
+// configureHTTP2 configures http2Transport from the configured transport.
+func (t *mtsTransport) configureHTTP2() {
+	base := t.conf.Transport
+	if base == nil {
+		// configure a copy so http.DefaultTransport is left untouched
+		base = http.DefaultTransport.(*http.Transport).Clone()
+	}
+
+	t.http2Transport = base
+
+	if transport, ok := base.(*http.Transport); ok {
+		if err := http2.ConfigureTransport(transport); err != nil {
+			// transport is still usable, it may just not negotiate HTTP/2
+			t.logr.Warn("failed to configure transport for HTTP/2", slog.Any("error", err))
+		}
+	}
+}
+
 // RoundTrip implements http.Transport.
 func (t *mtsTransport) RoundTrip(req *http.Request) (*http.Response, error) {
 	if t.conf.GetAuthToken() == "" {
@@ -62,8 +91,20 @@ func (t *mtsTransport) RoundTrip(req *http.Request) (*http.Response, error) {
 	// set basic auth
 	req.SetBasicAuth(t.conf.GetAuthToken(), "")
 
+	// apply custom headers, restoring auth in case the transformer changed it
+	if t.conf.HeaderTransformer != nil {
+		auth := req.Header.Get("Authorization")
+		t.conf.HeaderTransformer(req.Header)
+		req.Header.Set("Authorization", auth)
+	}
+
+	base := t.getWrappedTransport()
+	if t.conf.Retry != nil {
+		base = &RetryTransport{Base: base, Config: t.conf.Retry}
+	}
+
 	// perform request
-	resp, err := t.getWrappedTransport().RoundTrip(req)
+	resp, err := base.RoundTrip(req)
 	if err != nil {
 		return nil, fmt.Errorf("request failed: %w", err)
 	}
@@ -75,20 +116,28 @@ func (t *mtsTransport) RoundTrip(req *http.Request) (*http.Response, error) {
 
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
 		// non 2XX status codes should be mapped to response errors
-		return nil, mapResponseToError(resp)
+		err := mapResponseToError(resp, correlationID)
+
+		if t.conf.OnError != nil {
+			t.conf.OnError(err, req)
+		}
+
+		return nil, err
 	}
 
 	return resp, nil
 }
 
-// mapResponseToError maps a non-2XX http.Response to an *Error.
-func mapResponseToError(resp *http.Response) *Error {
+// mapResponseToError maps a non-2XX http.Response to an *Error tagged with the
+// request's correlation ID.
+func mapResponseToError(resp *http.Response, correlationID string) *Error {
 	var errResp ErrorResponse
 
 	defer resp.Body.Close()
 	json.NewDecoder(resp.Body).Decode(&errResp)
 
 	err := errResp.Error
+	err.CorrelationID = correlationID
 
 	if err.ErrorCode == 0 {
 		err.ErrorCode = resp.StatusCode
@@ -101,8 +150,18 @@ func mapResponseToError(resp *http.Response) *Error {
 	return &err
 }
 
+// getLogger gets the logger carried by ctx or falls back to the transport's
+// logger.
+func (t *mtsTransport) getLogger(ctx context.Context) *slog.Logger {
+	if logr := LoggerFromContext(ctx); logr != nil {
+		return logr
+	}
+
+	return t.logr
+}
+
 func (t *mtsTransport) logRequest(req *http.Request, correlationID string) {
-	logr := t.logr.With(slog.String("correlationID", correlationID))
+	logr := t.getLogger(req.Context()).With(slog.String("correlationID", correlationID))
 
 	reqBytes, err := httputil.DumpRequestOut(req, true)
 	if err != nil {
@@ -110,11 +169,11 @@ func (t *mtsTransport) logRequest(req *http.Request, correlationID string) {
 		logr.ErrorContext(req.Context(), "failed to dump request", slog.Any("error", err))
 	}
 
-	t.logr.DebugContext(req.Context(), "outbound request", slog.String("request", string(reqBytes)))
+	logr.DebugContext(req.Context(), "outbound request", slog.String("request", string(reqBytes)))
 }
 
 func (t *mtsTransport) logResponse(resp *http.Response, correlationID string) {
-	logr := t.logr.With(slog.String("correlationID", correlationID))
+	logr := t.getLogger(resp.Request.Context()).With(slog.String("correlationID", correlationID))
 
 	respBytes, err := httputil.DumpResponse(resp, true)
 	if err != nil {
@@ -122,7 +181,7 @@ func (t *mtsTransport) logResponse(resp *http.Response, correlationID string) {
 		logr.ErrorContext(resp.Request.Context(), "failed to dump response", slog.Any("error", err))
 	}
 
-	t.logr.DebugContext(resp.Request.Context(), "received response", slog.String("r", string(respBytes)))
+	logr.DebugContext(resp.Request.Context(), "received response", slog.String("r", string(respBytes)))
 }
 
 // httpGet makes an HTTP GET request with the given client.
@@ -145,20 +204,71 @@ func httpDelete[T any](ctx context.Context, c *client, path string) (*T, error)
 	return httpDo[T](ctx, c, http.MethodDelete, path, nil)
 }
 
+// withQuery appends the query string encoded from q to path. q must be a
+// pointer to a struct, which may be nil.
+func withQuery(path string, q any) (string, error) {
+	values, err := query.Values(q)
+	if err != nil {
+		return "", fmt.Errorf("could not marshal query: %w", err)
+	}
+
+	if len(values) == 0 {
+		return path, nil
+	}
+
+	return path + "?" + values.Encode(), nil
+}
+
 func httpDo[T any](ctx context.Context, c *client, method, path string, body any) (*T, error) {
+	out, _, err := httpDoWithHeader[T](ctx, c, method, path, body, nil)
+	return out, err
+}
+
+// httpDoWithHeader makes an HTTP request with the given client, adding header
+// to the request. Returns the response headers alongside the mapped body.
+func httpDoWithHeader[T any](ctx context.Context, c *client, method, path string, body any, header http.Header) (*T, http.Header, error) {
+	if d, ok := requestTimeoutFromContext(ctx); ok {
+		var cancel context.CancelFunc
+
+		ctx, cancel = context.WithTimeout(ctx, d)
+		defer cancel()
+	}
+
 	url := c.conf.GetBaseURL() + path
 
 	req, err := newHTTPRequest(ctx, method, url, body)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
+	}
+
+	for key, values := range header {
+		req.Header[key] = values
 	}
 
 	resp, err := c.httpClient.Do(req)
 	if err != nil {
-		return nil, err
+		c.audit(ctx, method, path, body, nil, err)
+		return nil, nil, err
 	}
 
-	return mapResponseBody[T](c, resp)
+	out, err := mapResponseBody[T](c, resp)
+
+	if err != nil {
+		c.audit(ctx, method, path, body, nil, err)
+	} else {
+		c.audit(ctx, method, path, body, out, nil)
+	}
+
+	return out, resp.Header, err
+}
+
+// audit logs the operation with the configured AuditLogger, if any.
+func (c *client) audit(ctx context.Context, method, path string, req, resp any, err error) {
+	if c.conf.AuditLogger == nil || !isAuditedMethod(method) {
+		return
+	}
+
+	c.conf.AuditLogger.LogOperation(ctx, newAuditOperation(method, path, req, resp, err))
 }
 
 func newHTTPRequest(ctx context.Context, method, reqURL string, body any) (*http.Request, error) {
@@ -205,6 +315,15 @@ func mapResponseBody[T any](c *client, resp *http.Response) (*T, error) {
 	var out T
 
 	dec := json.NewDecoder(resp.Body)
+
+	if c.conf.StrictJSON {
+		dec.DisallowUnknownFields()
+	}
+
+	if c.conf.JSONDecodeHook != nil {
+		c.conf.JSONDecodeHook(dec)
+	}
+
 	defer func() {
 		if err := resp.Body.Close(); err != nil {
 			c.logr.ErrorContext(resp.Request.Context(), "failed to close response body", slog.Any("error", err))
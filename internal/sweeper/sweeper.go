@@ -2,7 +2,9 @@ package sweeper
 
 import (
 	"context"
+	"errors"
 	"log/slog"
+	"net/http"
 	"strings"
 	"sync"
 
@@ -63,6 +65,36 @@ func (s *Sweeper) CollectWithPrefix(ctx context.Context, prefix string) error {
 	return nil
 }
 
+// CollectByStatus collects all employees with the given status and names
+// prefixed by the given string and slates them for deletion. Useful for finding
+// test employees leaked while still clocked in.
+func (s *Sweeper) CollectByStatus(ctx context.Context, status gomts.EmployeeStatus, prefix string) error {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	employees, err := s.c.Employees().List(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, employee := range employees {
+		if employee.Status == status && strings.HasPrefix(employee.Name, prefix) {
+			s.employeeIDs = append(s.employeeIDs, employee.ID)
+		}
+	}
+
+	return nil
+}
+
+// ClockOutAll clocks out all employees slated for deletion, for APIs which
+// reject deleting clocked in employees.
+//
+// Not yet implemented: the client has no way to clock an employee out. Always
+// returns gomts.ErrNotImplemented.
+func (s *Sweeper) ClockOutAll(ctx context.Context) error {
+	return gomts.ErrNotImplemented
+}
+
 // Sweep cleans up all resources slated for deletion.
 // Any individual errors are rolled up into an gomts.ErrorList and returned.
 func (s *Sweeper) Sweep(ctx context.Context) error {
@@ -73,8 +105,9 @@ func (s *Sweeper) Sweep(ctx context.Context) error {
 
 	// delete all employees
 	for _, id := range s.employeeIDs {
-		if _, err := s.c.Employees().Delete(ctx, id); err != nil {
+		if _, err := s.c.Employees().Delete(ctx, id); err != nil && !isNotFound(err) {
 			errList = append(errList, err)
+			continue
 		}
 
 		s.logr.InfoContext(ctx, "deleted employee", slog.Any("employee_id", id))
@@ -82,8 +115,9 @@ func (s *Sweeper) Sweep(ctx context.Context) error {
 
 	// delete all departments
 	for _, id := range s.departmentIDs {
-		if _, err := s.c.Departments().Delete(ctx, id); err != nil {
+		if _, err := s.c.Departments().Delete(ctx, id); err != nil && !isNotFound(err) {
 			errList = append(errList, err)
+			continue
 		}
 
 		s.logr.InfoContext(ctx, "deleted department", slog.Any("department_id", id))
@@ -96,12 +130,25 @@ func (s *Sweeper) Sweep(ctx context.Context) error {
 	return errList
 }
 
+// isNotFound reports whether err is a 404 from the MyTimeStation API, meaning
+// the resource was already deleted (e.g., by the test itself).
+func isNotFound(err error) bool {
+	var mtsErr *gomts.Error
+	return errors.As(err, &mtsErr) && mtsErr.ErrorCode == http.StatusNotFound
+}
+
 // AddEmployee adds an employee to be deleted.
 func (s *Sweeper) AddEmployee(id string) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
 	s.employeeIDs = append(s.employeeIDs, id)
 }
 
 // AddDepartment adds a department to be deleted.
 func (s *Sweeper) AddDepartment(id string) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
 	s.departmentIDs = append(s.departmentIDs, id)
 }
@@ -0,0 +1,64 @@
+package sweeper
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.charbar.io/gomts"
+)
+
+func TestSweeperConcurrentAdd(t *testing.T) {
+	s := NewSweeper(nil, slog.Default())
+
+	var employees, departments atomic.Int64
+
+	// the group only returns once all of its parallel subtests have finished
+	t.Run("group", func(t *testing.T) {
+		for i := range 50 {
+			t.Run(fmt.Sprint(i), func(t *testing.T) {
+				t.Parallel()
+
+				s.AddEmployee(fmt.Sprintf("emp_%d", i))
+				employees.Add(1)
+
+				s.AddDepartment(fmt.Sprintf("dep_%d", i))
+				departments.Add(1)
+			})
+		}
+	})
+
+	assert.Len(t, s.employeeIDs, int(employees.Load()))
+	assert.Len(t, s.departmentIDs, int(departments.Load()))
+}
+
+func TestSweeperCollectByStatus(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v1.2/employees", r.URL.Path)
+
+		fmt.Fprint(w, `{"employees": [
+			{"employee_id": "emp_1", "name": "test bob", "status": "in"},
+			{"employee_id": "emp_2", "name": "test joy", "status": "out"},
+			{"employee_id": "emp_3", "name": "bob", "status": "in"},
+			{"employee_id": "emp_4", "name": "test ann", "status": "in"}
+		]}`)
+	}))
+	t.Cleanup(srv.Close)
+
+	client := gomts.NewClient(&gomts.Config{
+		Protocol:  "http",
+		Host:      srv.Listener.Addr().String(),
+		AuthToken: "token",
+	})
+
+	s := NewSweeper(client, slog.Default())
+
+	assert.NoError(t, s.CollectByStatus(context.Background(), gomts.EmployeeInStatus, "test "))
+	assert.Equal(t, []string{"emp_1", "emp_4"}, s.employeeIDs)
+	assert.Empty(t, s.departmentIDs)
+}
@@ -0,0 +1,10 @@
+// Package mock provides testify mocks of the gomts client interfaces for
+// testing code which depends on the MyTimeStation API.
+//
+// The mocks are generated with mockery; run `make generate` after changing any
+// of the mocked interfaces.
+package mock
+
+//go:generate mockery --srcpkg=go.charbar.io/gomts --name=Client --output=. --outpkg=mock --filename=client.go
+//go:generate mockery --srcpkg=go.charbar.io/gomts --name=EmployeeClient --output=. --outpkg=mock --filename=employee_client.go
+//go:generate mockery --srcpkg=go.charbar.io/gomts --name=DepartmentClient --output=. --outpkg=mock --filename=department_client.go
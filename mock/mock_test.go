@@ -0,0 +1,32 @@
+package mock_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	testifymock "github.com/stretchr/testify/mock"
+	"go.charbar.io/gomts"
+	"go.charbar.io/gomts/mock"
+)
+
+// compile-time assertions that the mocks fulfil their interfaces.
+var (
+	_ gomts.Client           = (*mock.Client)(nil)
+	_ gomts.EmployeeClient   = (*mock.EmployeeClient)(nil)
+	_ gomts.DepartmentClient = (*mock.DepartmentClient)(nil)
+)
+
+func TestMockEmployeeClient(t *testing.T) {
+	employees := mock.NewEmployeeClient(t)
+	employees.On("Get", testifymock.Anything, "emp_1").
+		Return(&gomts.Employee{ID: "emp_1", Name: "bob ross"}, nil).
+		Once()
+
+	client := mock.NewClient(t)
+	client.On("Employees").Return(employees)
+
+	employee, err := client.Employees().Get(context.Background(), "emp_1")
+	assert.NoError(t, err)
+	assert.Equal(t, "bob ross", employee.Name)
+}
@@ -0,0 +1,123 @@
+package gomts
+
+import "time"
+
+// PayPeriod represents a single payroll period. Start is inclusive and End is
+// exclusive.
+type PayPeriod struct {
+	// Start is the first instant of the pay period.
+	Start time.Time
+
+	// End is the first instant after the pay period.
+	End time.Time
+}
+
+// Contains reports whether t falls within the pay period.
+func (p PayPeriod) Contains(t time.Time) bool {
+	return !t.Before(p.Start) && t.Before(p.End)
+}
+
+// PayPeriodGenerator iterates over consecutive pay periods.
+type PayPeriodGenerator interface {
+	// Next advances the generator and returns the next pay period.
+	Next() PayPeriod
+
+	// Contains reports whether t falls within the pay period most recently
+	// returned by Next.
+	Contains(t time.Time) bool
+}
+
+// NewWeeklyGenerator returns a PayPeriodGenerator of 7 day pay periods, the
+// first of which begins at start.
+func NewWeeklyGenerator(start time.Time) PayPeriodGenerator {
+	return &fixedPayPeriodGenerator{next: start, days: 7}
+}
+
+// NewBiWeeklyGenerator returns a PayPeriodGenerator of 14 day pay periods, the
+// first of which begins at start.
+func NewBiWeeklyGenerator(start time.Time) PayPeriodGenerator {
+	return &fixedPayPeriodGenerator{next: start, days: 14}
+}
+
+// NewSemiMonthlyGenerator returns a PayPeriodGenerator of pay periods running
+// from the 1st to the 15th and from the 16th to the end of each month, in
+// local time. The first period is the one containing the current time.
+func NewSemiMonthlyGenerator() PayPeriodGenerator {
+	now := time.Now()
+
+	day := 1
+	if now.Day() > 15 {
+		day = 16
+	}
+
+	return &calendarPayPeriodGenerator{
+		next:        time.Date(now.Year(), now.Month(), day, 0, 0, 0, 0, now.Location()),
+		semiMonthly: true,
+	}
+}
+
+// NewMonthlyGenerator returns a PayPeriodGenerator of calendar month pay
+// periods, in local time. The first period is the current month.
+func NewMonthlyGenerator() PayPeriodGenerator {
+	now := time.Now()
+
+	return &calendarPayPeriodGenerator{
+		next: time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, now.Location()),
+	}
+}
+
+// fixedPayPeriodGenerator implements PayPeriodGenerator for pay periods of a
+// fixed number of days.
+type fixedPayPeriodGenerator struct {
+	next    time.Time
+	days    int
+	current PayPeriod
+}
+
+func (g *fixedPayPeriodGenerator) Next() PayPeriod {
+	// AddDate keeps period boundaries on the same wall clock time across DST
+	// changes
+	g.current = PayPeriod{Start: g.next, End: g.next.AddDate(0, 0, g.days)}
+	g.next = g.current.End
+
+	return g.current
+}
+
+func (g *fixedPayPeriodGenerator) Contains(t time.Time) bool {
+	return g.current.Contains(t)
+}
+
+// calendarPayPeriodGenerator implements PayPeriodGenerator for pay periods
+// aligned to calendar months.
+type calendarPayPeriodGenerator struct {
+	next        time.Time
+	semiMonthly bool
+	current     PayPeriod
+}
+
+func (g *calendarPayPeriodGenerator) Next() PayPeriod {
+	start := g.next
+
+	// time.Date normalizes month 13 into January of the following year
+	end := time.Date(start.Year(), start.Month()+1, 1, 0, 0, 0, 0, start.Location())
+
+	if g.semiMonthly && start.Day() == 1 {
+		end = time.Date(start.Year(), start.Month(), 16, 0, 0, 0, 0, start.Location())
+	}
+
+	g.current = PayPeriod{Start: start, End: end}
+	g.next = end
+
+	return g.current
+}
+
+func (g *calendarPayPeriodGenerator) Contains(t time.Time) bool {
+	return g.current.Contains(t)
+}
+
+// compile-time assertions that the generator implementations fulfil
+// PayPeriodGenerator interface.
+var (
+	_ PayPeriodGenerator = (*fixedPayPeriodGenerator)(nil)
+	_ PayPeriodGenerator = (*calendarPayPeriodGenerator)(nil)
+)
@@ -0,0 +1,51 @@
+package gomts_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.charbar.io/gomts"
+)
+
+func TestWeeklyGenerator(t *testing.T) {
+	start := time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+	gen := gomts.NewWeeklyGenerator(start)
+
+	first := gen.Next()
+	assert.Equal(t, start, first.Start)
+	assert.Equal(t, start.AddDate(0, 0, 7), first.End)
+	assert.True(t, gen.Contains(start.Add(time.Hour)))
+	assert.False(t, gen.Contains(first.End))
+
+	second := gen.Next()
+	assert.Equal(t, first.End, second.Start)
+	assert.True(t, gen.Contains(first.End))
+}
+
+func TestSemiMonthlyGenerator(t *testing.T) {
+	gen := gomts.NewSemiMonthlyGenerator()
+
+	prev := gen.Next()
+	assert.True(t, gen.Contains(time.Now()))
+
+	for i := 0; i < 24; i++ {
+		period := gen.Next()
+
+		assert.Equal(t, prev.End, period.Start)
+		assert.Contains(t, []int{1, 16}, period.Start.Day())
+		assert.Contains(t, []int{1, 16}, period.End.Day())
+
+		prev = period
+	}
+}
+
+func TestMonthlyGenerator(t *testing.T) {
+	gen := gomts.NewMonthlyGenerator()
+
+	period := gen.Next()
+	assert.True(t, gen.Contains(time.Now()))
+	assert.Equal(t, 1, period.Start.Day())
+	assert.Equal(t, period.Start.AddDate(0, 1, 0), period.End)
+}
@@ -0,0 +1,107 @@
+package gomts
+
+import (
+	"net/http"
+	"sync"
+	"time"
+)
+
+// RateLimitTransport is an http.RoundTripper which limits the rate of requests
+// made with Base, waiting until a request is allowed. Requests are allowed at
+// a steady rate, with up to a burst of requests allowed at once after a
+// period of inactivity.
+//
+// A RateLimitTransport can be specified as Config.Transport, or wrap it, to
+// share a limit between clients.
+type RateLimitTransport struct {
+	// Base is the transport used to make requests. Defaults to
+	// http.DefaultTransport.
+	Base http.RoundTripper
+
+	// interval is the time between requests at the steady rate.
+	interval time.Duration
+
+	// burst is the number of requests allowed at once.
+	burst int
+
+	// mtx protects next
+	mtx *sync.Mutex
+
+	// next is when the next request would be allowed at the steady rate,
+	// ignoring the burst.
+	next time.Time
+}
+
+// NewRateLimitTransport returns a RateLimitTransport allowing perSecond
+// requests per second with bursts of up to burst requests. A perSecond of 0 or
+// less means no limit. A burst less than 1 is treated as 1.
+func NewRateLimitTransport(base http.RoundTripper, perSecond float64, burst int) *RateLimitTransport {
+	if burst < 1 {
+		burst = 1
+	}
+
+	t := &RateLimitTransport{
+		Base:  base,
+		burst: burst,
+		mtx:   new(sync.Mutex),
+	}
+
+	// a zero interval allows every request immediately
+	if perSecond > 0 {
+		t.interval = time.Duration(float64(time.Second) / perSecond)
+	}
+
+	return t
+}
+
+// RoundTrip implements http.RoundTripper. Returns the request context's error
+// if it is done before the request is allowed.
+func (t *RateLimitTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if wait := t.reserve(time.Now()); wait > 0 {
+		timer := time.NewTimer(wait)
+		defer timer.Stop()
+
+		select {
+		case <-req.Context().Done():
+			// give the reservation back so cancelled requests do not delay
+			// later ones
+			t.cancel()
+			return nil, req.Context().Err()
+		case <-timer.C:
+		}
+	}
+
+	base := t.Base
+	if base == nil {
+		base = http.DefaultTransport
+	}
+
+	return base.RoundTrip(req)
+}
+
+// reserve reserves the next allowed request and returns how long to wait
+// from now until it is allowed.
+func (t *RateLimitTransport) reserve(now time.Time) time.Duration {
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+
+	if t.next.Before(now) {
+		t.next = now
+	}
+
+	// the burst allows running ahead of the steady rate by up to burst-1
+	// requests
+	allowed := t.next.Add(-time.Duration(t.burst-1) * t.interval)
+	t.next = t.next.Add(t.interval)
+
+	return allowed.Sub(now)
+}
+
+// cancel returns a reservation which was not used. Reservations made since
+// keep their wait, so the rate is only ever lower than allowed.
+func (t *RateLimitTransport) cancel() {
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+
+	t.next = t.next.Add(-t.interval)
+}
@@ -0,0 +1,104 @@
+package gomts_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.charbar.io/gomts"
+)
+
+// roundTripperFunc implements http.RoundTripper with a function.
+type roundTripperFunc func(req *http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func TestRateLimitTransport(t *testing.T) {
+	var times []time.Time
+
+	transport := gomts.NewRateLimitTransport(roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		times = append(times, time.Now())
+		return &http.Response{StatusCode: http.StatusOK}, nil
+	}), 20, 2)
+
+	start := time.Now()
+
+	for range 4 {
+		req, _ := http.NewRequest(http.MethodGet, "http://localhost", nil)
+
+		_, err := transport.RoundTrip(req)
+		assert.NoError(t, err)
+	}
+
+	// the burst is allowed at once, then one request per 50ms
+	assert.Less(t, times[1].Sub(start), 25*time.Millisecond)
+	assert.GreaterOrEqual(t, times[3].Sub(start), 90*time.Millisecond)
+}
+
+func TestRateLimitPerSecond(t *testing.T) {
+	client := fakeServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"departments": []}`)
+	}, func(conf *gomts.Config) {
+		conf.RateLimitPerSecond = 0.5
+	})
+
+	ctx := context.Background()
+
+	_, err := client.Departments().List(ctx)
+	assert.NoError(t, err)
+
+	// the next request is not allowed for another 2s
+	ctx, cancel := context.WithTimeout(ctx, 50*time.Millisecond)
+	defer cancel()
+
+	_, err = client.Departments().List(ctx)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func TestRateLimitTransportCancel(t *testing.T) {
+	transport := gomts.NewRateLimitTransport(roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		return &http.Response{StatusCode: http.StatusOK}, nil
+	}), 10, 1)
+
+	roundTrip := func(ctx context.Context) error {
+		req, _ := http.NewRequestWithContext(ctx, http.MethodGet, "http://localhost", nil)
+
+		_, err := transport.RoundTrip(req)
+		return err
+	}
+
+	assert.NoError(t, roundTrip(context.Background()))
+
+	// each cancelled request would otherwise push the next one back by 100ms
+	for range 5 {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Millisecond)
+		assert.ErrorIs(t, roundTrip(ctx), context.DeadlineExceeded)
+		cancel()
+	}
+
+	start := time.Now()
+	assert.NoError(t, roundTrip(context.Background()))
+	assert.Less(t, time.Since(start), 200*time.Millisecond)
+}
+
+func TestRateLimitTransportNoLimit(t *testing.T) {
+	transport := gomts.NewRateLimitTransport(roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		return &http.Response{StatusCode: http.StatusOK}, nil
+	}), 0, 1)
+
+	start := time.Now()
+
+	for range 100 {
+		req, _ := http.NewRequest(http.MethodGet, "http://localhost", nil)
+
+		_, err := transport.RoundTrip(req)
+		assert.NoError(t, err)
+	}
+
+	assert.Less(t, time.Since(start), 100*time.Millisecond)
+}
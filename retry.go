@@ -0,0 +1,159 @@
+package gomts
+
+import (
+	"io"
+	"net/http"
+	"time"
+)
+
+// BackoffFunc returns how long to wait before retrying a request. attempt is
+// the number of the upcoming retry, starting at 1. resp and err are the result
+// of the previous attempt; either may be nil.
+type BackoffFunc func(attempt int, resp *http.Response, err error) time.Duration
+
+// RetryConfig configures retrying failed requests; see RetryTransport.
+type RetryConfig struct {
+	// MaxRetries is the maximum number of retries of a request. Defaults to 3.
+	MaxRetries int
+
+	// Backoff determines how long to wait between retries. Defaults to
+	// ExponentialBackoff(1*time.Second, 30*time.Second).
+	Backoff BackoffFunc
+}
+
+// GetMaxRetries gets the configured maximum number of retries or the default.
+func (c *RetryConfig) GetMaxRetries() int {
+	if c.MaxRetries <= 0 {
+		return defaultMaxRetries
+	}
+
+	return c.MaxRetries
+}
+
+// GetBackoff gets the configured backoff or the default.
+func (c *RetryConfig) GetBackoff() BackoffFunc {
+	if c.Backoff == nil {
+		return ExponentialBackoff(1*time.Second, 30*time.Second)
+	}
+
+	return c.Backoff
+}
+
+// ExponentialBackoff returns a BackoffFunc which waits min before the first
+// retry and doubles the wait for every retry after, up to max.
+func ExponentialBackoff(min, max time.Duration) BackoffFunc {
+	return func(attempt int, _ *http.Response, _ error) time.Duration {
+		d := min
+
+		for i := 1; i < attempt && d < max; i++ {
+			d *= 2
+		}
+
+		if d > max {
+			return max
+		}
+
+		return d
+	}
+}
+
+// LinearBackoff returns a BackoffFunc which waits base multiplied by the retry
+// attempt.
+func LinearBackoff(base time.Duration) BackoffFunc {
+	return func(attempt int, _ *http.Response, _ error) time.Duration {
+		return base * time.Duration(attempt)
+	}
+}
+
+// ConstantBackoff returns a BackoffFunc which always waits d.
+func ConstantBackoff(d time.Duration) BackoffFunc {
+	return func(int, *http.Response, error) time.Duration {
+		return d
+	}
+}
+
+// RetryTransport is an http.RoundTripper which retries requests made with Base
+// that fail with a network error, 429 Too Many Requests or a 5XX status code,
+// waiting between retries as configured by Config. Only idempotent methods are
+// retried, so a create is never made twice.
+//
+// Config.Retry wraps the client's transport in a RetryTransport.
+type RetryTransport struct {
+	// Base is the transport used to make requests. Defaults to
+	// http.DefaultTransport.
+	Base http.RoundTripper
+
+	// Config configures the retries. Defaults to a zero RetryConfig.
+	Config *RetryConfig
+}
+
+// RoundTrip implements http.RoundTripper. Returns the request context's error
+// if it is done while waiting to retry.
+func (t *RetryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	base := t.Base
+	if base == nil {
+		base = http.DefaultTransport
+	}
+
+	conf := t.Config
+	if conf == nil {
+		conf = new(RetryConfig)
+	}
+
+	for attempt := 1; ; attempt++ {
+		resp, err := base.RoundTrip(req)
+
+		if attempt > conf.GetMaxRetries() || !shouldRetry(req, resp, err) {
+			return resp, err
+		}
+
+		wait := conf.GetBackoff()(attempt, resp, err)
+
+		if resp != nil {
+			// drain the body so the connection can be reused
+			io.Copy(io.Discard, resp.Body)
+			resp.Body.Close()
+		}
+
+		timer := time.NewTimer(wait)
+
+		select {
+		case <-req.Context().Done():
+			timer.Stop()
+			return nil, req.Context().Err()
+		case <-timer.C:
+		}
+
+		if req.Body != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+
+			req = req.Clone(req.Context())
+			req.Body = body
+		}
+	}
+}
+
+// shouldRetry reports whether req should be retried after it resulted in resp
+// and err.
+func shouldRetry(req *http.Request, resp *http.Response, err error) bool {
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+	default:
+		return false
+	}
+
+	// the body must be replayable
+	if req.Body != nil && req.GetBody == nil {
+		return false
+	}
+
+	if err != nil {
+		// do not retry requests cancelled by the caller
+		return req.Context().Err() == nil
+	}
+
+	return resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= 500
+}
@@ -0,0 +1,102 @@
+package gomts_test
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.charbar.io/gomts"
+)
+
+func TestBackoff(t *testing.T) {
+	exponential := gomts.ExponentialBackoff(time.Second, 10*time.Second)
+	linear := gomts.LinearBackoff(time.Second)
+	constant := gomts.ConstantBackoff(time.Second)
+
+	for attempt, want := range map[int][3]time.Duration{
+		1:  {time.Second, time.Second, time.Second},
+		2:  {2 * time.Second, 2 * time.Second, time.Second},
+		4:  {8 * time.Second, 4 * time.Second, time.Second},
+		5:  {10 * time.Second, 5 * time.Second, time.Second},
+		64: {10 * time.Second, 64 * time.Second, time.Second},
+	} {
+		assert.Equal(t, want[0], exponential(attempt, nil, nil), "exponential attempt %d", attempt)
+		assert.Equal(t, want[1], linear(attempt, nil, nil), "linear attempt %d", attempt)
+		assert.Equal(t, want[2], constant(attempt, nil, nil), "constant attempt %d", attempt)
+	}
+
+	assert.Equal(t, 30*time.Second, new(gomts.RetryConfig).GetBackoff()(10, nil, nil))
+	assert.Equal(t, 3, new(gomts.RetryConfig).GetMaxRetries())
+}
+
+func TestRetry(t *testing.T) {
+	var (
+		mtx    sync.Mutex
+		calls  int
+		bodies []string
+	)
+
+	client := fakeServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		b, _ := io.ReadAll(r.Body)
+
+		mtx.Lock()
+		calls++
+		n := calls
+		bodies = append(bodies, string(b))
+		mtx.Unlock()
+
+		switch {
+		case r.URL.Path == "/v1.2/employees/emp_failing", n < 3:
+			w.WriteHeader(http.StatusServiceUnavailable)
+		default:
+			fmt.Fprint(w, `{"employee": {"employee_id": "emp_1"}, "department": {}}`)
+		}
+	}, func(conf *gomts.Config) {
+		conf.Retry = &gomts.RetryConfig{MaxRetries: 2, Backoff: gomts.ConstantBackoff(time.Millisecond)}
+	})
+
+	ctx := context.Background()
+
+	reset := func() {
+		mtx.Lock()
+		defer mtx.Unlock()
+
+		calls, bodies = 0, nil
+	}
+
+	t.Run("retries idempotent requests with the body", func(t *testing.T) {
+		reset()
+
+		employee, err := client.Employees().Update(ctx, "emp_1", new(gomts.EmployeeUpdateRequest).WithTitle("Painter"))
+		assert.NoError(t, err)
+		assert.Equal(t, "emp_1", employee.ID)
+
+		assert.Equal(t, 3, calls)
+		assert.Equal(t, bodies[0], bodies[2])
+		assert.Contains(t, bodies[2], "Painter")
+	})
+
+	t.Run("gives up after max retries", func(t *testing.T) {
+		reset()
+
+		_, err := client.Employees().Get(ctx, "emp_failing")
+
+		var mtsErr *gomts.Error
+		assert.ErrorAs(t, err, &mtsErr)
+		assert.Equal(t, http.StatusServiceUnavailable, mtsErr.ErrorCode)
+		assert.Equal(t, 3, calls)
+	})
+
+	t.Run("does not retry creates", func(t *testing.T) {
+		reset()
+
+		_, err := client.Departments().Create(ctx, &gomts.DepartmentCreateRequest{Name: "painting"})
+		assert.Error(t, err)
+		assert.Equal(t, 1, calls)
+	})
+}
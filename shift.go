@@ -0,0 +1,114 @@
+package gomts
+
+import (
+	"context"
+	"sort"
+	"time"
+)
+
+// Shift represents a scheduled shift of an employee. The MyTimeStation API
+// does not expose schedules yet, so shifts are built by callers, e.g. from
+// their own scheduling system, to be checked with DetectConflicts.
+type Shift struct {
+	// ID is the unique identifier for the shift.
+	ID string `json:"shift_id"`
+
+	// EmployeeID is the unique identifier of the employee scheduled.
+	EmployeeID string `json:"employee_id"`
+
+	// DepartmentID is the unique identifier of the department scheduled in.
+	DepartmentID string `json:"department_id"`
+
+	// Start is when the shift starts.
+	Start time.Time `json:"start"`
+
+	// End is when the shift ends.
+	End time.Time `json:"end"`
+}
+
+// ShiftConflict is a pair of shifts scheduling the same employee at the same
+// time.
+type ShiftConflict struct {
+	// ShiftA is the shift starting first.
+	ShiftA Shift
+
+	// ShiftB is the shift starting second.
+	ShiftB Shift
+
+	// OverlapStart is when the shifts start to overlap.
+	OverlapStart time.Time
+
+	// OverlapEnd is when the shifts stop overlapping.
+	OverlapEnd time.Time
+}
+
+// DetectConflicts finds every pair of shifts scheduling the same employee at
+// overlapping times. Shifts which only touch, one ending as the other starts,
+// do not conflict. Conflicts are ordered by employee, then by the start of
+// ShiftA.
+//
+// Shifts are swept in order of start time per employee, keeping only the
+// shifts still active, so it runs in O(n log n + k) for n shifts and k
+// conflicts.
+func DetectConflicts(shifts []Shift) []ShiftConflict {
+	sorted := make([]Shift, len(shifts))
+	copy(sorted, shifts)
+
+	sort.SliceStable(sorted, func(i, j int) bool {
+		if sorted[i].EmployeeID != sorted[j].EmployeeID {
+			return sorted[i].EmployeeID < sorted[j].EmployeeID
+		}
+
+		return sorted[i].Start.Before(sorted[j].Start)
+	})
+
+	var (
+		out    []ShiftConflict
+		active []Shift
+	)
+
+	for i, shift := range sorted {
+		if i > 0 && shift.EmployeeID != sorted[i-1].EmployeeID {
+			active = active[:0]
+		}
+
+		// drop shifts which ended before this one starts
+		n := 0
+
+		for _, other := range active {
+			if other.End.After(shift.Start) {
+				active[n] = other
+				n++
+			}
+		}
+
+		active = active[:n]
+
+		for _, other := range active {
+			end := other.End
+			if shift.End.Before(end) {
+				end = shift.End
+			}
+
+			if !end.After(shift.Start) {
+				// shift is empty
+				continue
+			}
+
+			out = append(out, ShiftConflict{
+				ShiftA:       other,
+				ShiftB:       shift,
+				OverlapStart: shift.Start,
+				OverlapEnd:   end,
+			})
+		}
+
+		active = append(active, shift)
+	}
+
+	return out
+}
+
+func (c *employeeClient) ValidateSchedule(ctx context.Context, employeeID string, newShift Shift) ([]ShiftConflict, error) {
+	return nil, ErrNotImplemented
+}
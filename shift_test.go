@@ -0,0 +1,31 @@
+package gomts_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.charbar.io/gomts"
+)
+
+func TestDetectConflicts(t *testing.T) {
+	at := func(hour int) time.Time {
+		return time.Date(2024, time.March, 4, hour, 0, 0, 0, time.UTC)
+	}
+
+	morning := gomts.Shift{ID: "shift_1", EmployeeID: "emp_1", Start: at(8), End: at(12)}
+	midday := gomts.Shift{ID: "shift_2", EmployeeID: "emp_1", Start: at(10), End: at(14)}
+	afternoon := gomts.Shift{ID: "shift_3", EmployeeID: "emp_1", Start: at(14), End: at(18)}
+	other := gomts.Shift{ID: "shift_4", EmployeeID: "emp_2", Start: at(9), End: at(17)}
+
+	conflicts := gomts.DetectConflicts([]gomts.Shift{afternoon, other, midday, morning})
+
+	assert.Equal(t, []gomts.ShiftConflict{{
+		ShiftA:       morning,
+		ShiftB:       midday,
+		OverlapStart: at(10),
+		OverlapEnd:   at(12),
+	}}, conflicts)
+
+	assert.Empty(t, gomts.DetectConflicts([]gomts.Shift{morning, afternoon, other}))
+}
@@ -0,0 +1,81 @@
+package gomts
+
+import "context"
+
+// Summary is a snapshot of employee and department statistics.
+type Summary struct {
+	// TotalEmployees is the number of employees. Employees with an unknown
+	// status are counted here but in neither ClockedIn nor ClockedOut.
+	TotalEmployees int
+
+	// ClockedIn is the number of employees clocked in, including those on a
+	// break.
+	ClockedIn int
+
+	// ClockedOut is the number of employees clocked out.
+	ClockedOut int
+
+	// TotalDepartments is the number of departments.
+	TotalDepartments int
+
+	// NewEmployeesToday is the number of employees created today.
+	NewEmployeesToday int
+}
+
+// Summary lists employees and departments concurrently. If either list fails,
+// the statistics of the other are still returned alongside an ErrorList.
+func (c *client) Summary(ctx context.Context) (*Summary, error) {
+	var (
+		employees   []Employee
+		departments []Department
+
+		// collect both errors rather than the first so partial results are
+		// returned
+		employeesErr, departmentsErr error
+	)
+
+	_ = runConcurrently(
+		func() error {
+			employees, employeesErr = c.employees.List(ctx)
+			return nil
+		},
+		func() error {
+			departments, departmentsErr = c.departments.List(ctx)
+			return nil
+		},
+	)
+
+	today := dayRange(c.now())
+
+	out := &Summary{
+		TotalEmployees:   len(employees),
+		TotalDepartments: len(departments),
+	}
+
+	for _, employee := range employees {
+		switch {
+		case employee.Status.IsClockedIn():
+			out.ClockedIn++
+		case employee.Status == EmployeeOutStatus:
+			out.ClockedOut++
+		}
+
+		if !employee.CreatedAt.Before(today.Start) && employee.CreatedAt.Before(today.End) {
+			out.NewEmployeesToday++
+		}
+	}
+
+	var errList ErrorList
+
+	for _, err := range []error{employeesErr, departmentsErr} {
+		if err != nil {
+			errList = append(errList, err)
+		}
+	}
+
+	if len(errList) > 0 {
+		return out, errList
+	}
+
+	return out, nil
+}
@@ -0,0 +1,66 @@
+package gomts_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.charbar.io/gomts"
+)
+
+func TestClientSummary(t *testing.T) {
+	now := time.Now()
+
+	if midnight := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location()); now.Sub(midnight) < time.Minute {
+		t.Skip("skipping as creation times would span midnight")
+	}
+
+	departmentsFail := false
+
+	client := fakeServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/v1.2/employees":
+			fmt.Fprintf(w, `{"employees": [
+				{"employee_id": "emp_1", "status": "in", "created_at": %q},
+				{"employee_id": "emp_2", "status": "break", "created_at": "2020-01-01T00:00:00Z"},
+				{"employee_id": "emp_3", "status": "out", "created_at": "2020-01-01T00:00:00Z"},
+				{"employee_id": "emp_4", "status": "", "created_at": "2020-01-01T00:00:00Z"}
+			]}`, now.Add(-time.Second).Format(time.RFC3339Nano))
+
+		case "/v1.2/departments":
+			if departmentsFail {
+				w.WriteHeader(http.StatusInternalServerError)
+				return
+			}
+
+			fmt.Fprint(w, `{"departments": [{"department_id": "dep_1"}]}`)
+		}
+	})
+
+	ctx := context.Background()
+
+	summary, err := client.Summary(ctx)
+	assert.NoError(t, err)
+
+	assert.Equal(t, &gomts.Summary{
+		TotalEmployees:    4,
+		ClockedIn:         2,
+		ClockedOut:        1,
+		TotalDepartments:  1,
+		NewEmployeesToday: 1,
+	}, summary)
+
+	departmentsFail = true
+
+	summary, err = client.Summary(ctx)
+
+	var errList gomts.ErrorList
+	assert.ErrorAs(t, err, &errList)
+	assert.Equal(t, 1, errList.Len())
+
+	assert.Equal(t, 4, summary.TotalEmployees)
+	assert.Equal(t, 0, summary.TotalDepartments)
+}
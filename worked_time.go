@@ -0,0 +1,134 @@
+package gomts
+
+import (
+	"sort"
+	"time"
+)
+
+// timeSummary summarizes the time an employee spent in each status within a
+// date range, derived from their clock events.
+type timeSummary struct {
+	// worked is the time spent clocked in and not on a break.
+	worked time.Duration
+
+	// onBreak is the time spent on a break.
+	onBreak time.Duration
+
+	// currentSession is the time since the employee last clocked in, if they
+	// are still clocked in at the end of the range.
+	currentSession time.Duration
+}
+
+// summarizeClockEvents summarizes the clock events of a single employee within
+// r. Events outside of r are ignored.
+//
+// The status before the first event in r is inferred: clocked out if the first
+// event clocks in, otherwise clocked in. If there are no events in r and r
+// includes now, the employee's current status is assumed throughout. The
+// status after the last event lasts until the end of r or now, whichever is
+// earlier.
+func summarizeClockEvents(events []ClockEvent, current EmployeeStatus, r DateRange, now time.Time) timeSummary {
+	events = sortedClockEvents(events)
+
+	end := r.End
+	if now.Before(end) {
+		end = now
+	}
+
+	var (
+		summary      timeSummary
+		status       EmployeeStatus
+		since        = r.Start
+		sessionStart = r.Start
+		first        = true
+	)
+
+	for _, event := range events {
+		if event.Timestamp.Before(r.Start) || !event.Timestamp.Before(end) {
+			continue
+		}
+
+		if first {
+			status = EmployeeInStatus
+			if event.Direction == EmployeeInStatus {
+				status = EmployeeOutStatus
+			}
+
+			first = false
+		}
+
+		summary.add(status, event.Timestamp.Sub(since))
+
+		if event.Direction == EmployeeInStatus && status == EmployeeOutStatus {
+			sessionStart = event.Timestamp
+		}
+
+		status = event.Direction
+		since = event.Timestamp
+	}
+
+	if first && !now.Before(r.Start) && now.Before(r.End) {
+		status = current
+	}
+
+	if end.After(since) {
+		summary.add(status, end.Sub(since))
+	}
+
+	if status == EmployeeInStatus || status == EmployeeOnBreakStatus {
+		summary.currentSession = end.Sub(sessionStart)
+	}
+
+	return summary
+}
+
+func (s *timeSummary) add(status EmployeeStatus, d time.Duration) {
+	switch status {
+	case EmployeeInStatus:
+		s.worked += d
+	case EmployeeOnBreakStatus:
+		s.onBreak += d
+	}
+}
+
+// sortedClockEvents returns a copy of events sorted by timestamp.
+func sortedClockEvents(events []ClockEvent) []ClockEvent {
+	out := make([]ClockEvent, len(events))
+	copy(out, events)
+
+	sort.SliceStable(out, func(i, j int) bool {
+		return out[i].Timestamp.Before(out[j].Timestamp)
+	})
+
+	return out
+}
+
+// groupClockEventsByEmployee groups clock events by employee ID.
+func groupClockEventsByEmployee(events []ClockEvent) map[string][]ClockEvent {
+	out := make(map[string][]ClockEvent)
+
+	for _, event := range events {
+		out[event.EmployeeID] = append(out[event.EmployeeID], event)
+	}
+
+	return out
+}
+
+// dayRange returns the range from midnight to midnight of the day of t, in
+// t's location.
+func dayRange(t time.Time) DateRange {
+	start := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+
+	return DateRange{Start: start, End: start.AddDate(0, 0, 1)}
+}
+
+// weekRange returns the range from midnight on the Monday of the week of t to
+// midnight on the following Monday, in t's location.
+func weekRange(t time.Time) DateRange {
+	start := dayRange(t).Start
+
+	// shift Sunday from the start to the end of the week
+	start = start.AddDate(0, 0, -(int(start.Weekday())+6)%7)
+
+	return DateRange{Start: start, End: start.AddDate(0, 0, 7)}
+}